@@ -0,0 +1,55 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Bundled word lists for --category, one word per line, any length; the file name is the category.
+//
+//go:embed categories/*.txt
+var categoryFS embed.FS
+
+// categoryNames lists the bundled categories, sorted.
+func categoryNames() []string {
+	files, _ := fs.Glob(categoryFS, "categories/*.txt")
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = strings.TrimSuffix(path.Base(f), ".txt")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadCategory returns the words of the named bundled category.
+func loadCategory(name string) (map[string]struct{}, error) {
+	f, err := categoryFS.Open("categories/" + name + ".txt")
+	if err != nil {
+		return nil, fmt.Errorf("unknown --category %q (available: %s)", name, strings.Join(categoryNames(), ", "))
+	}
+	defer f.Close()
+	return readWordSet(f)
+}
+
+// printCategories lists the categories for --list-categories with how many of their
+// words have the current --length.
+func printCategories() error {
+	for _, name := range categoryNames() {
+		set, err := loadCategory(name)
+		if err != nil {
+			return err
+		}
+		n := 0
+		for w := range set {
+			if letterCount(w) == wordLength {
+				n++
+			}
+		}
+		fmt.Printf("%-10s %d %d-letter words\n", name, n, wordLength)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// configPath returns the path of name inside the gimme-five-go directory under the user's
+// config dir (os.UserConfigDir: ~/.config on Linux, ~/Library/Application Support on macOS,
+// %AppData% on Windows), creating the directory if needed.
+func configPath(name string) (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating config directory: %w", err)
+	}
+	dir := filepath.Join(base, "gimme-five-go")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// curve is a named roll delay preset for --curve: the per-step delays that replace
+// rollDelaysMs, and a line describing its feel for --list-curves.
+type curve struct {
+	desc   string
+	delays []int // ms per step; all presets have len(rollDelaysMs) steps so --rounds-length defaults don't change
+}
+
+// defaultCurve is used when --curve is unset or unknown.
+const defaultCurve = "default"
+
+var curves = map[string]curve{
+	defaultCurve: {
+		desc:   "roulette: speeds up, spins, then slows to a stop",
+		delays: rollDelaysMs,
+	},
+	"slow": {
+		desc:   "the default shape at half again the length, for a relaxed reveal",
+		delays: []int{1500, 1350, 1200, 1050, 900, 750, 600, 600, 600, 680, 820, 1020, 1200, 1500, 2250, 3000},
+	},
+	"fast": {
+		desc:   "the default shape in half the time",
+		delays: []int{500, 450, 400, 350, 300, 250, 200, 200, 200, 225, 275, 340, 400, 500, 750, 1000},
+	},
+	"snappy": {
+		desc:   "a quick, even flicker with a short landing",
+		delays: []int{300, 250, 200, 150, 120, 100, 100, 100, 100, 100, 120, 150, 200, 300, 450, 600},
+	},
+	"suspense": {
+		desc:   "a fast blur, then a long, drawn-out slowdown",
+		delays: []int{600, 400, 250, 150, 100, 100, 100, 100, 150, 250, 400, 650, 1000, 1500, 2200, 3000},
+	},
+}
+
+// curveNames are the --curve presets, default first and the rest alphabetically.
+func curveNames() []string {
+	names := make([]string, 0, len(curves))
+	for name := range curves {
+		if name != defaultCurve {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return append([]string{defaultCurve}, names...)
+}
+
+// selectCurve makes the named preset the roll curve, warning and keeping the default
+// if there is no such preset.
+func selectCurve(name string) {
+	c, ok := curves[name]
+	if !ok {
+		warnLog.Printf("unknown --curve %q, using %s", name, defaultCurve)
+		c = curves[defaultCurve]
+	}
+	rollDelaysMs = c.delays
+}
+
+// printCurves lists the presets for --list-curves with a full default-length round's duration.
+func printCurves() {
+	for _, name := range curveNames() {
+		c := curves[name]
+		total := 0
+		for _, d := range c.delays {
+			total += d
+		}
+		fmt.Printf("%-9s %4.1fs  %s\n", name, float64(total)/1000, c.desc)
+	}
+}
+
+// dramaSteps is how many of the last delays --drama stretches; dramaStretch is the extra
+// factor per step toward the end, so the final one lasts 1+dramaSteps*dramaStretch (1.8x) as long.
+const (
+	dramaSteps   = 4
+	dramaStretch = 0.2
+)
+
+// dramatize returns delays with the tail slowed progressively (--drama): the steps before it keep
+// their pace, so the roll spins as usual and only the settling takes longer.
+func dramatize(delays []int) []int {
+	out := append([]int(nil), delays...)
+	tail := min(dramaSteps, len(out))
+	for i := 0; i < tail; i++ {
+		pos := len(out) - tail + i
+		out[pos] = int(float64(out[pos]) * (1 + dramaStretch*float64(dramaSteps-tail+i+1)))
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+)
+
+// dailySeed turns a calendar date into a seed (YYYYMMDD), the same for everyone that day.
+func dailySeed(date time.Time) int64 {
+	y, m, d := date.Date()
+	return int64(y*10000 + int(m)*100 + d)
+}
+
+// dailyIndex maps a date to a stable index in [0, n), so the daily word depends only on the date and word list.
+func dailyIndex(date time.Time, n int) int {
+	return rand.New(rand.NewSource(dailySeed(date))).Intn(n)
+}
+
+// dailyCache is the last --daily --once word, kept in daily.json under configPath so
+// repeated calls on the same day skip loading and filtering the dictionary.
+type dailyCache struct {
+	Date    string `json:"date"`    // YYYY-MM-DD
+	Filters string `json:"filters"` // filtersHash when the word was picked
+	Word    string `json:"word"`
+}
+
+// outputOnlyFlags change how the daily word is printed, not which word it is.
+var outputOnlyFlags = map[string]bool{
+	"once": true, "1": true, "json": true, "case": true, "quiet": true, "verbose": true, "daily": true, "format": true,
+}
+
+// filtersHash fingerprints everything that decides the word list: the word length, every
+// other flag given, and the size and mtime of the files they name.
+func filtersHash() string {
+	var parts []string
+	flag.Visit(func(f *flag.Flag) {
+		if !outputOnlyFlags[f.Name] {
+			parts = append(parts, f.Name+"="+f.Value.String())
+		}
+	})
+	sort.Strings(parts)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "length=%d\n", wordLength)
+	for _, p := range parts {
+		fmt.Fprintln(h, p)
+	}
+	for _, path := range []string{dictPath, allowlistPath, blocklistPath} {
+		if info, err := os.Stat(path); path != "" && err == nil {
+			fmt.Fprintf(h, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// cachedDailyWord returns the cached word for date if it was picked with the current filters.
+func cachedDailyWord(date time.Time) (string, bool) {
+	path, err := configPath("daily.json")
+	if err != nil {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	var c dailyCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return "", false
+	}
+	if c.Date != date.Format(time.DateOnly) || c.Filters != filtersHash() || c.Word == "" {
+		return "", false
+	}
+	return c.Word, true
+}
+
+// saveDailyWord caches word as date's daily word under the current filters.
+func saveDailyWord(date time.Time, word string) error {
+	path, err := configPath("daily.json")
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(dailyCache{Date: date.Format(time.DateOnly), Filters: filtersHash(), Word: word}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// A few hundred short definitions for the d key, kept small so the binary stays lean;
+// --dict-defs swaps in a fuller file in the same format: word, a tab, the definition.
+//
+//go:embed definitions.txt
+var definitionsTxt []byte
+
+// noDefinition is shown under words the definitions don't cover.
+const noDefinition = "no definition available"
+
+// loadDefinitions reads --dict-defs when given, else the bundled definitions.
+func loadDefinitions() (map[string]string, error) {
+	if defsPath == "" {
+		return parseDefinitions(bytes.NewReader(definitionsTxt))
+	}
+	f, err := os.Open(defsPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading definitions: %w", err)
+	}
+	defer f.Close()
+	return parseDefinitions(f)
+}
+
+// parseDefinitions reads "word<TAB>definition" lines (a space works instead of the tab),
+// skipping blank and # comment lines and any line over maxLineLen. Words are lowercased;
+// the first definition wins.
+func parseDefinitions(r io.Reader) (map[string]string, error) {
+	defs := make(map[string]string)
+	err := eachLine(r, func(raw []byte) {
+		line := bytes.TrimSpace(raw)
+		if isCommentOrBlank(line) {
+			return
+		}
+		word, def, ok := strings.Cut(string(line), "\t")
+		if !ok {
+			word, def, _ = strings.Cut(string(line), " ")
+		}
+		word, def = strings.ToLower(strings.TrimSpace(word)), strings.TrimSpace(def)
+		if _, dup := defs[word]; !dup && def != "" {
+			defs[word] = def
+		}
+	})
+	return defs, err
+}
+
+// definitionWidth is where the d panel wraps (narrower in a smaller terminal).
+const definitionWidth = 60
+
+// definitionView is the d panel: each final word of the round with its definition.
+func (m model) definitionView() string {
+	lines := make([]string, 0, len(m.rolls))
+	for _, r := range m.rolls {
+		w := r.finalWord(m.words)
+		def, ok := definitions[w]
+		if !ok {
+			def = noDefinition
+		}
+		lines = append(lines, displayWord(w)+": "+def)
+	}
+	width := definitionWidth
+	if m.width > 0 {
+		width = min(width, m.width)
+	}
+	return m.styles.definition.Width(width).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,55 @@
+package main
+
+import "sort"
+
+// letterFreq is the relative frequency (%) of each letter in English text.
+var letterFreq = map[rune]float64{
+	'e': 12.70, 't': 9.06, 'a': 8.17, 'o': 7.51, 'i': 6.97, 'n': 6.75, 's': 6.33,
+	'h': 6.09, 'r': 5.99, 'd': 4.25, 'l': 4.03, 'c': 2.78, 'u': 2.76, 'm': 2.41,
+	'w': 2.36, 'f': 2.23, 'g': 2.02, 'y': 1.97, 'p': 1.93, 'b': 1.49, 'v': 0.98,
+	'k': 0.77, 'j': 0.15, 'x': 0.15, 'q': 0.10, 'z': 0.07,
+}
+
+// difficultyBand is the fraction of the list kept by --difficulty (the top or bottom third).
+const difficultyBand = 3
+
+// letterScore is the mean letterFreq of a word's letters: high for words built from
+// common letters (e, t, a...), low for words with rare ones (j, q, x, z).
+func letterScore(w string) float64 {
+	var sum float64
+	n := 0
+	for _, c := range w {
+		sum += letterFreq[c]
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// applyDifficulty keeps the top ("easy") or bottom ("hard") third of words by letterScore,
+// in their original order; any other level keeps everything.
+func applyDifficulty(words []string, level string) []string {
+	if level != "easy" && level != "hard" || len(words) == 0 {
+		return words
+	}
+	order := make([]int, len(words))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return letterScore(words[order[a]]) > letterScore(words[order[b]])
+	})
+	keep := max(len(words)/difficultyBand, 1)
+	band := order[:keep]
+	if level == "hard" {
+		band = order[len(order)-keep:]
+	}
+	sort.Ints(band)
+	out := make([]string, len(band))
+	for i, idx := range band {
+		out[i] = words[idx]
+	}
+	return out
+}
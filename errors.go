@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/luismascotto/gimme-five-go/gimme"
+)
+
+// kindError is an error whose message is written for people but which still matches one of
+// the gimme sentinel errors (its kind) with errors.Is, so main can pick the exit status.
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string { return e.msg }
+func (e *kindError) Unwrap() error { return e.kind }
+
+// errorOf formats a message as fmt.Errorf does (including %w) and tags it with kind.
+func errorOf(kind error, format string, args ...any) error {
+	return &kindError{kind: kind, msg: fmt.Errorf(format, args...).Error()}
+}
+
+// Exit statuses beyond the generic 1; 2 matches what the flag package uses for bad usage.
+const (
+	exitBadPattern      = 2
+	exitEmptyDictionary = 3
+	exitNoMatch         = 4
+)
+
+// exitCode maps an error from run to the process exit status.
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, gimme.ErrBadPattern):
+		return exitBadPattern
+	case errors.Is(err, gimme.ErrEmptyDictionary):
+		return exitEmptyDictionary
+	case errors.Is(err, gimme.ErrNoMatch):
+		return exitNoMatch
+	}
+	return 1
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type starredMsg struct {
+	added bool
+	err   error
+}
+
+// loadFavorites returns the saved words in the order they were starred; a missing file is empty.
+func loadFavorites() ([]string, error) {
+	path, err := configPath("favorites.txt")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var words []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if w := strings.TrimSpace(sc.Text()); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words, sc.Err()
+}
+
+// addFavorite appends word to the favorites file unless it's already there.
+func addFavorite(word string) (bool, error) {
+	saved, err := loadFavorites()
+	if err != nil {
+		return false, err
+	}
+	for _, w := range saved {
+		if w == word {
+			return false, nil
+		}
+	}
+	path, err := configPath("favorites.txt")
+	if err != nil {
+		return false, err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return false, err
+	}
+	if _, err := fmt.Fprintln(f, word); err != nil {
+		f.Close()
+		return false, err
+	}
+	return true, f.Close()
+}
+
+// starWords saves words to favorites off the UI goroutine; added is true if any was new.
+func starWords(words []string) tea.Cmd {
+	return func() tea.Msg {
+		var msg starredMsg
+		for _, w := range words {
+			added, err := addFavorite(w)
+			if err != nil {
+				return starredMsg{err: err}
+			}
+			msg.added = msg.added || added
+		}
+		return msg
+	}
+}
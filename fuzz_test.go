@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzMatchesPattern(f *testing.F) {
+	f.Add("crane", "c_a_e")
+	f.Add("crane", "cr")
+	f.Add("cañón", "ca_ó_")
+	f.Add("", "_")
+	f.Add("slate", "s1a-e")
+	f.Fuzz(func(t *testing.T, word, pattern string) {
+		n := utf8.RuneCountInString(word)
+		got := matchesPattern(word, pattern)
+		if got != matchesPattern(word, pattern) {
+			t.Fatalf("matchesPattern(%q, %q) is not deterministic", word, pattern)
+		}
+		if got && utf8.RuneCountInString(pattern) != n {
+			t.Fatalf("matchesPattern(%q, %q) = true with %d vs %d runes", word, pattern, n, utf8.RuneCountInString(pattern))
+		}
+		if !matchesPattern(word, strings.Repeat("_", n)) {
+			t.Fatalf("matchesPattern(%q, all '_') = false, want true", word)
+		}
+		if !matchesPattern(word, word) {
+			t.Fatalf("matchesPattern(%q, itself) = false, want true", word)
+		}
+		if n > 0 && matchesPattern(word, strings.Repeat("_", n-1)) {
+			t.Fatalf("matchesPattern(%q, %d '_') = true, want false", word, n-1)
+		}
+		if !got {
+			return
+		}
+		// A match survives blanking any letter and fails once any letter is changed.
+		p := []rune(pattern)
+		for i, c := range p {
+			if c == '_' {
+				continue
+			}
+			p[i] = '_'
+			if !matchesPattern(word, string(p)) {
+				t.Fatalf("matchesPattern(%q, %q) = false after blanking position %d of %q", word, string(p), i, pattern)
+			}
+			p[i] = other(c)
+			if matchesPattern(word, string(p)) {
+				t.Fatalf("matchesPattern(%q, %q) = true after changing position %d of %q", word, string(p), i, pattern)
+			}
+			p[i] = c
+		}
+	})
+}
+
+// other returns a rune different from c that is neither '_' nor changed by a []rune round trip.
+func other(c rune) rune {
+	if c == 'a' {
+		return 'b'
+	}
+	return 'a'
+}
+
+func FuzzLoadWords(f *testing.F) {
+	f.Add([]byte("crane\nslate\n"))
+	f.Add([]byte("# comment\n\n  Crane \r\ncrane"))
+	f.Add([]byte("ap\xffle\ncañón\n\xc3(\n"))
+	f.Add(bytes.Repeat([]byte("x"), 2*maxLineLen))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		dictRanked = 0
+		for _, w := range loadWords(bytes.NewReader(data)) {
+			if n := utf8.RuneCountInString(w); n != wordLength {
+				t.Fatalf("loadWords kept %q with %d runes, want %d", w, n, wordLength)
+			}
+			if !isLetters(w) {
+				t.Fatalf("loadWords kept non-letter word %q", w)
+			}
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package gimme
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Failure modes of loading and filtering a word list, for callers that build one from a
+// dictionary and user filters before handing it to a Picker. Match them with errors.Is;
+// the errors actually returned usually carry a more specific message.
+var (
+	// ErrEmptyDictionary means the source had no valid words of the wanted length at all.
+	ErrEmptyDictionary = errors.New("gimme: no usable words in the dictionary")
+	// ErrNoMatch means the dictionary had words, but none passed the filters.
+	ErrNoMatch = errors.New("gimme: no words match the filters")
+	// ErrBadPattern means a pattern or regular expression filter could not be used.
+	ErrBadPattern = errors.New("gimme: bad pattern")
+)
+
+// CheckWords reports whether words can be drawn from. total is how many usable words the
+// source had before filtering: with none left, the error is ErrEmptyDictionary if total is 0
+// and ErrNoMatch otherwise.
+func CheckWords(words []string, total int) error {
+	switch {
+	case len(words) > 0:
+		return nil
+	case total == 0:
+		return ErrEmptyDictionary
+	}
+	return ErrNoMatch
+}
+
+// patternError describes a malformed pattern and matches ErrBadPattern.
+type patternError struct{ msg string }
+
+func (e *patternError) Error() string        { return e.msg }
+func (e *patternError) Is(target error) bool { return target == ErrBadPattern }
+
+// CheckPattern validates a pattern for words of length letters: one rune per letter, each
+// a letter or '_' for any letter. Errors match ErrBadPattern.
+func CheckPattern(pattern string, length int) error {
+	if n := utf8.RuneCountInString(pattern); n != length {
+		return &patternError{fmt.Sprintf("pattern %q has %d characters, want %d", pattern, n, length)}
+	}
+	for _, c := range pattern {
+		if c != '_' && !unicode.IsLetter(c) {
+			return &patternError{fmt.Sprintf("pattern %q may only contain letters and '_'", pattern)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package gimme
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckWords(t *testing.T) {
+	tests := []struct {
+		words []string
+		total int
+		want  error
+	}{
+		{[]string{"crane"}, 1, nil},
+		{nil, 0, ErrEmptyDictionary},
+		{nil, 12, ErrNoMatch},
+	}
+	for _, tt := range tests {
+		if err := CheckWords(tt.words, tt.total); err != tt.want {
+			t.Errorf("CheckWords(%q, %d) = %v, want %v", tt.words, tt.total, err, tt.want)
+		}
+	}
+}
+
+func TestCheckPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		ok      bool
+	}{
+		{"cr_n_", true},
+		{"_____", true},
+		{"cañón", true},
+		{"cr_n", false},
+		{"cr_n__", false},
+		{"cr_n1", false},
+		{"cr-n_", false},
+	}
+	for _, tt := range tests {
+		err := CheckPattern(tt.pattern, 5)
+		if tt.ok && err != nil {
+			t.Errorf("CheckPattern(%q, 5) = %v, want nil", tt.pattern, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrBadPattern) {
+			t.Errorf("CheckPattern(%q, 5) = %v, want ErrBadPattern", tt.pattern, err)
+		}
+	}
+}
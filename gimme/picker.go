@@ -0,0 +1,199 @@
+// Package gimme is the word-picking core of gimme-five-go: a shuffled index pool
+// consumed in order, reshuffled when exhausted, so draws are fair and repeat-free per cycle.
+package gimme
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Picker draws random words from a fixed list. It is not safe for concurrent use.
+type Picker struct {
+	words     []string
+	weights   []float64 // optional per-word weights biasing shuffle order (nil = uniform)
+	deferred  []bool    // optional per-word flags: these go after all others in every shuffle (nil = none)
+	rng       *rand.Rand
+	indices   []int // shuffled indices into words
+	cursor    int
+	lastShown int // last index handed out, so a refill never repeats it immediately (-1 = none)
+
+	shuffleSeed int64 // seeds the current permutation, so State can rebuild it
+	prevLast    int   // lastShown when the current permutation was made
+}
+
+// State is a snapshot of a Picker's position: enough to rebuild the current shuffle
+// and resume it with Restore, e.g. across program runs. The word list itself is not included.
+type State struct {
+	Seed   int64 `json:"seed"`
+	Prev   int   `json:"prev"`
+	Cursor int   `json:"cursor"`
+	Size   int   `json:"size"`
+}
+
+// ErrStateMismatch is returned by Restore when a State was taken over a different word list.
+var ErrStateMismatch = errors.New("gimme: state does not match the word list")
+
+// NewPicker returns a Picker over words (not copied) whose shuffles are fully determined by seed.
+func NewPicker(words []string, seed int64) *Picker {
+	return NewPickerWithRand(words, rand.New(rand.NewSource(seed)))
+}
+
+// NewPickerWithRand is NewPicker with a caller-supplied source of randomness, which the
+// Picker then owns; useful for sharing one RNG across pickers or fixing it in tests.
+func NewPickerWithRand(words []string, rng *rand.Rand) *Picker {
+	p := &Picker{words: words, rng: rng, lastShown: -1}
+	p.shuffle()
+	return p
+}
+
+// NewWeightedPicker is NewPickerWithRand with each shuffle biased by weights (one per word,
+// all > 0): heavier words tend to come earlier in every cycle. Every word still appears once per cycle.
+func NewWeightedPicker(words []string, weights []float64, rng *rand.Rand) *Picker {
+	p := &Picker{words: words, weights: weights, rng: rng, lastShown: -1}
+	p.shuffle()
+	return p
+}
+
+// Next returns the next word, or "" if the list is empty.
+func (p *Picker) Next() string {
+	if len(p.words) == 0 {
+		return ""
+	}
+	return p.words[p.take(1)[0]]
+}
+
+// Draw returns the next n indices into the word list. They are distinct while n
+// does not exceed the list size; beyond that, words repeat across reshuffles.
+func (p *Picker) Draw(n int) []int {
+	if len(p.words) == 0 {
+		return nil
+	}
+	out := make([]int, 0, n)
+	for len(out) < n {
+		out = append(out, p.take(min(n-len(out), len(p.words)))...)
+	}
+	return out
+}
+
+// Defer moves the words flagged in deferred (one flag per word) behind all the others in
+// every shuffle, so they are only drawn once the rest of the cycle is used up. Both groups
+// keep their own random (or weighted) order. It rebuilds the current shuffle, so call it
+// before drawing; nil clears it.
+func (p *Picker) Defer(deferred []bool) {
+	p.deferred = deferred
+	p.permute()
+	p.cursor = 0
+}
+
+// Remaining is how many indices are left before the next reshuffle.
+func (p *Picker) Remaining() int {
+	return len(p.indices) - p.cursor
+}
+
+// State reports the Picker's current position.
+func (p *Picker) State() State {
+	return State{Seed: p.shuffleSeed, Prev: p.prevLast, Cursor: p.cursor, Size: len(p.words)}
+}
+
+// Restore rebuilds the shuffle described by st and resumes at its cursor. Later reshuffles
+// are derived from st.Seed. It fails with ErrStateMismatch if st was taken over a list of
+// a different size, leaving the Picker unchanged.
+func (p *Picker) Restore(st State) error {
+	if st.Size != len(p.words) || st.Cursor < 0 || st.Cursor > st.Size || st.Prev < -1 || st.Prev >= st.Size {
+		return ErrStateMismatch
+	}
+	p.rng = rand.New(rand.NewSource(st.Seed))
+	p.shuffleSeed, p.prevLast = st.Seed, st.Prev
+	p.permute()
+	p.cursor = st.Cursor
+	p.lastShown = st.Prev
+	if st.Cursor > 0 {
+		p.lastShown = p.indices[st.Cursor-1]
+	}
+	return nil
+}
+
+// shuffle refills the pool with a new permutation and resets the cursor. Each permutation
+// gets its own seed drawn from rng, so it can be rebuilt from State alone.
+func (p *Picker) shuffle() {
+	p.shuffleSeed = p.rng.Int63()
+	p.prevLast = p.lastShown
+	p.permute()
+	p.cursor = 0
+}
+
+// permute sets indices to the permutation determined by shuffleSeed and prevLast.
+func (p *Picker) permute() {
+	r := rand.New(rand.NewSource(p.shuffleSeed))
+	n := len(p.words)
+	idx := make([]int, n)
+	for i := 0; i < n; i++ {
+		idx[i] = i
+	}
+	if p.weights != nil {
+		p.weightedOrder(idx, r)
+	} else {
+		r.Shuffle(n, func(i, j int) { idx[i], idx[j] = idx[j], idx[i] })
+	}
+	head := n // how much of idx the swap below may draw from
+	if p.deferred != nil {
+		head = p.partition(idx)
+		if head < 2 {
+			head = n
+		}
+	}
+	// Don't open the new shuffle with the word that closed the previous one.
+	if n > 1 && idx[0] == p.prevLast {
+		k := 1 + r.Intn(head-1)
+		idx[0], idx[k] = idx[k], idx[0]
+	}
+	p.indices = idx
+}
+
+// partition stably moves the deferred indices to the end of idx and returns how many are not deferred.
+func (p *Picker) partition(idx []int) int {
+	out := make([]int, 0, len(idx))
+	for _, i := range idx {
+		if !p.deferred[i] {
+			out = append(out, i)
+		}
+	}
+	head := len(out)
+	for _, i := range idx {
+		if p.deferred[i] {
+			out = append(out, i)
+		}
+	}
+	copy(idx, out)
+	return head
+}
+
+// weightedOrder sorts idx by the Efraimidis–Spirakis key u^(1/w), a random permutation
+// in which each next index is drawn with probability proportional to its weight.
+func (p *Picker) weightedOrder(idx []int, r *rand.Rand) {
+	keys := make([]float64, len(p.words))
+	for _, i := range idx {
+		keys[i] = math.Pow(r.Float64(), 1/p.weights[i])
+	}
+	sort.Slice(idx, func(a, b int) bool { return keys[idx[a]] > keys[idx[b]] })
+}
+
+func (p *Picker) ensureCapacity(need int) {
+	if p.Remaining() < need {
+		p.shuffle()
+	}
+}
+
+// take consumes the next n indices; n must not exceed len(p.words).
+func (p *Picker) take(n int) []int {
+	p.ensureCapacity(n)
+	out := make([]int, n)
+	copy(out, p.indices[p.cursor:p.cursor+n])
+	p.cursor += n
+	if n > 0 {
+		p.lastShown = out[n-1]
+	}
+	return out
+}
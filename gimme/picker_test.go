@@ -0,0 +1,110 @@
+package gimme
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+var testWords = []string{"crane", "slate", "adieu", "roate", "soare", "trace", "crate", "stare", "raise", "arise"}
+
+func newTestPicker(seed int64) *Picker {
+	return NewPickerWithRand(testWords, rand.New(rand.NewSource(seed)))
+}
+
+func TestDrawAdvancesCursor(t *testing.T) {
+	p := newTestPicker(1)
+	for _, n := range []int{1, 3, 4} {
+		before := p.cursor
+		got := p.Draw(n)
+		if len(got) != n {
+			t.Fatalf("Draw(%d) returned %d indices", n, len(got))
+		}
+		if p.cursor != before+n {
+			t.Fatalf("Draw(%d): cursor %d -> %d, want +%d", n, before, p.cursor, n)
+		}
+		for _, i := range got {
+			if i < 0 || i >= len(testWords) {
+				t.Fatalf("Draw(%d) returned out-of-range index %d", n, i)
+			}
+		}
+	}
+}
+
+func TestDrawIsDistinctWithinCycle(t *testing.T) {
+	p := newTestPicker(2)
+	seen := make(map[int]bool)
+	for _, i := range p.Draw(len(testWords)) {
+		if seen[i] {
+			t.Fatalf("index %d drawn twice in one cycle", i)
+		}
+		seen[i] = true
+	}
+}
+
+func TestTakeReshufflesWhenShort(t *testing.T) {
+	p := newTestPicker(3)
+	p.Draw(8)
+	if got := p.Remaining(); got != 2 {
+		t.Fatalf("Remaining after 8 of 10 = %d, want 2", got)
+	}
+	seed := p.shuffleSeed
+	p.take(3) // needs more than the 2 left
+	if p.shuffleSeed == seed {
+		t.Fatal("take(3) with 2 remaining did not reshuffle")
+	}
+	if p.cursor != 3 {
+		t.Fatalf("cursor after reshuffle and take(3) = %d, want 3", p.cursor)
+	}
+
+	seed = p.shuffleSeed
+	p.take(7) // exactly what's left
+	if p.shuffleSeed != seed {
+		t.Fatal("take(7) with 7 remaining reshuffled")
+	}
+}
+
+func TestStateRestoreRoundTrip(t *testing.T) {
+	p := newTestPicker(4)
+	p.Draw(4)
+	st := p.State()
+	want := p.Draw(6) // the rest of the cycle; later shuffles derive from st.Seed, not p's RNG
+
+	q := newTestPicker(99)
+	if err := q.Restore(st); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if q.State() != st {
+		t.Fatalf("State after Restore = %+v, want %+v", q.State(), st)
+	}
+	got := q.Draw(6)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("draw %d after Restore = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRestoreRejectsMismatch(t *testing.T) {
+	p := newTestPicker(5)
+	st := p.State()
+	st.Size++
+	if err := p.Restore(st); !errors.Is(err, ErrStateMismatch) {
+		t.Fatalf("Restore with wrong size: err = %v, want ErrStateMismatch", err)
+	}
+}
+
+func TestRefillNeverRepeatsLastWord(t *testing.T) {
+	words := []string{"crane", "slate"}
+	for seed := int64(0); seed < 200; seed++ {
+		p := NewPickerWithRand(words, rand.New(rand.NewSource(seed)))
+		prev := p.Draw(1)[0]
+		for i := 0; i < 20; i++ { // every second draw crosses a reshuffle
+			next := p.Draw(1)[0]
+			if next == prev {
+				t.Fatalf("seed %d, draw %d: %q repeated across a refill", seed, i+1, words[next])
+			}
+			prev = next
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// helpKey is one row of the ? overlay.
+type helpKey struct {
+	keys, action string
+}
+
+// helpKeys lists the TUI bindings, leaving out those disabled by flags.
+func helpKeys() []helpKey {
+	newRound := "Enter / scroll"
+	if noMouse {
+		newRound = "Enter"
+	}
+	keys := []helpKey{
+		{newRound, "new round"},
+		{"Enter / . (rolling)", "skip to the final word"},
+		{"space", "pause / resume the roll"},
+		{"backspace", "back one word (pauses the roll)"},
+	}
+	if browse {
+		keys = append(keys, helpKey{"← / →", "step through the round's words"})
+	} else {
+		keys = append(keys, helpKey{"r", "replay the last round"})
+	}
+	if scrambleWords {
+		keys = append(keys, helpKey{"s", "reveal the scrambled word"})
+	}
+	if reverseWords {
+		keys = append(keys, helpKey{"s", "flip between backwards and forwards"})
+	}
+	quit := "quit"
+	if confirmQuit {
+		quit = "quit (press twice while rolling)"
+	}
+	return append(keys,
+		helpKey{"c", "copy the word"},
+		helpKey{"f", "star the word (--favorites)"},
+		helpKey{"d", "show / hide the word's definition"},
+		helpKey{"R", "reshuffle the whole pool and start a new round"},
+		helpKey{"t", "next color theme (remembered)"},
+		helpKey{"i", "session summary"},
+		helpKey{"?", "toggle this help"},
+		helpKey{"q / Esc / Ctrl+C", quit},
+	)
+}
+
+// helpView renders the ? overlay: a bordered key table, centered by View.
+func (m model) helpView() string {
+	keys := helpKeys()
+	width := 0
+	for _, k := range keys {
+		width = max(width, lipgloss.Width(k.keys))
+	}
+	rows := make([]string, len(keys))
+	for i, k := range keys {
+		pad := strings.Repeat(" ", width-lipgloss.Width(k.keys))
+		rows[i] = fmt.Sprintf("%s%s   %s", k.keys, pad, k.action)
+	}
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 2)
+	source := fmt.Sprintf("word list: %s (%d words)", sourceLabel(), len(m.words))
+	return lipgloss.JoinVertical(lipgloss.Center, box.Render(strings.Join(rows, "\n")), m.styles.hint.Render(source), m.styles.hint.Render("any key to close"))
+}
+
+// infoView renders the i overlay: this session's rounds and distinct words, the pool,
+// and the lifetime round count as it will be saved on quit.
+func (m model) infoView() string {
+	rows := []string{
+		fmt.Sprintf("rounds this session   %d", m.played),
+		fmt.Sprintf("distinct words seen   %d", len(m.seenWords)),
+		fmt.Sprintf("left before reshuffle %d of %d", m.pool.Remaining(), len(m.words)),
+		fmt.Sprintf("rounds all-time       %d", m.stats.TotalRounds),
+	}
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 2)
+	return lipgloss.JoinVertical(lipgloss.Center, box.Render(strings.Join(rows, "\n")), m.styles.hint.Render("any key to close"))
+}
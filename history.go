@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// Every word a TUI session reveals is appended to history.txt under configPath,
+// one per line, so --prefer-new can tell fresh words from ones already seen.
+
+// loadSeen returns the set of words in the history file; a missing file is empty.
+func loadSeen() (map[string]bool, error) {
+	path, err := configPath("history.txt")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]bool{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	seen := make(map[string]bool)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if w := strings.TrimSpace(sc.Text()); w != "" {
+			seen[w] = true
+		}
+	}
+	return seen, sc.Err()
+}
+
+// appendHistory adds the words not already in the history file to it.
+func appendHistory(words []string) error {
+	seen, err := loadSeen()
+	if err != nil {
+		return err
+	}
+	path, err := configPath("history.txt")
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			fmt.Fprintln(&b, w)
+		}
+	}
+	if b.Len() == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// seenFlags marks which of words are in seen, for gimme.Picker.Defer.
+func seenFlags(words []string, seen map[string]bool) []bool {
+	flags := make([]bool, len(words))
+	for i, w := range words {
+		flags[i] = seen[w]
+	}
+	return flags
+}
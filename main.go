@@ -1,226 +1,2008 @@
 // gimme-five-go: CLI that picks a random 5-letter word for Wordle-like games,
-// with a roulette-style reveal. Words are loaded once from embedded words_alpha.txt.
+// with a roulette-style reveal. run loads the words once per start via loadDictionary:
+// from --allowlist, --dict (a file, or - for stdin) or the embedded words_alpha.txt.
 package main
 
 import (
 	"bufio"
 	"bytes"
 	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
 	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"text/template"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/luismascotto/gimme-five-go/gimme"
 )
 
 //go:embed words_alpha.txt
 var wordsAlphaTxt []byte
 
 // fiveLetterWords is populated once at startup from the embedded file.
+// Despite the name, it holds words of wordLength letters (5 unless --length is given).
 var fiveLetterWords []string
 
-// Roll delays (ms): accelerate, sustain, then slow to stop (roulette feel).
+// wordLength is the number of letters a word must have to be kept (--length).
+var wordLength int
+
+// unicodeLetters accepts any Unicode letter (ñ, é, ß...) in words and letter flags, not just a–z (--unicode-letters).
+var unicodeLetters bool
+
+// dictPath is an optional external word list used instead of the embedded one (--dict).
+var dictPath string
+
+// allowlistPath restricts the pool to exactly the valid words in this file, with no fallback (--allowlist).
+var allowlistPath string
+
+// blocklistPath lists words to drop (--blocklist); blocked is its lowercased contents.
+var (
+	blocklistPath string
+	blocked       map[string]struct{}
+)
+
+// excludeWords are single words to drop, given as repeated --exclude-word flags.
+var excludeWords stringList
+
+// stringList is a flag.Value collecting every occurrence of a repeatable flag.
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+// stdinDict is the --dict value that reads the word list from standard input.
+const stdinDict = "-"
+
+// dictSource names the list actually loaded: dictPath, or "" for the embedded one (also after a fallback).
+var dictSource string
+
+// dictRanked counts the distinct valid words loadWords saw before filtering: the highest rank.
+var dictRanked int
+
+// rankMin and rankMax keep only words ranked within [rankMin, rankMax] (1-based, 0 = unbounded),
+// the rank being a word's position in the dictionary (--rank-min, --rank-max).
+var rankMin, rankMax int
+
+// seed drives every shuffle; time-based unless --seed is given, so a sequence can be replayed.
+var seed int64
+
+// once prints a single word to stdout and exits, skipping the TUI (--once / -1).
+var once bool
+
+// count is how many words --once prints; allowRepeats permits more than the dictionary holds.
+var (
+	count        int
+	allowRepeats bool
+)
+
+// printHistory writes every revealed word to stdout when the TUI exits (--print-history).
+var printHistory bool
+
+// uniqueLetters keeps only words whose letters are all distinct (--unique-letters).
+var uniqueLetters bool
+
+// presentLetters and absentLetters are Wordle's yellow and gray letters (--present / --absent);
+// mergeFeedback folds them into includeLetters and excludeLetters.
+var (
+	presentLetters string
+	absentLetters  string
+)
+
+// includeLetters must all appear in a word; excludeLetters must not (--include / --exclude).
+var (
+	includeLetters string
+	excludeLetters string
+)
+
+// regexFlag is a Go regexp words must match (--regex); wordRegexp is its compiled form.
+// It's unanchored: use ^...$ for a full-word match.
+var (
+	regexFlag  string
+	wordRegexp *regexp.Regexp
+)
+
+// startsWith/endsWith keep only words beginning/ending with a letter (--starts-with / --ends-with).
+// A letter fixed by --pattern at the same position takes precedence.
+var (
+	startsWith string
+	endsWith   string
+)
+
+// pattern fixes letters by position, '_' meaning any letter, e.g. "c_a_e" (--pattern).
+var pattern string
+
+// speed divides every roll delay; 2 rolls twice as fast (--speed).
+var speed float64
+
+// instant skips the roll animation and shows the final word right away (--instant).
+var instant bool
+
+// showStats prints the lifetime stats and exits (--stats).
+var showStats bool
+
+// showSource prints which word list is in use (path or "embedded") and its size, then exits (--source).
+var showSource bool
+
+// spread makes consecutive --once words differ in at least this many positions (--spread).
+var spread int
+
+// dryRun loads and filters everything, prints a summary and exits without picking (--dry-run).
+var dryRun bool
+
+// category restricts the pool to a bundled themed list such as animals (--category);
+// categoryWords holds its words once loaded (nil = no restriction).
+var (
+	category      string
+	categoryWords map[string]struct{}
+)
+
+// defsPath replaces the bundled definitions shown with d (--dict-defs); definitions
+// holds whichever set was loaded when the TUI starts.
+var (
+	defsPath    string
+	definitions map[string]string
+)
+
+// listCategories prints the bundled categories and exits (--list-categories).
+var listCategories bool
+
+// countWords prints how many words pass the filters and exits (--count-words).
+var countWords bool
+
+// idleTimeoutSecs quits the TUI after that many seconds without input or a roll (--idle-timeout;
+// 0 = never); idleTimeout is the same as a Duration.
+var (
+	idleTimeoutSecs int
+	idleTimeout     time.Duration
+)
+
+// drama slows the last few steps of the roll further for a tenser landing (--drama).
+var drama bool
+
+// showSeed shows the session seed and round number under the stopped word, so a reveal can be shared (--show-seed).
+var showSeed bool
+
+// confirmQuit makes q/Esc during a roll ask to be pressed again before quitting (--confirm-quit).
+var confirmQuit bool
+
+// compactView renders just the word(s) on one line, for status bars and small panes (--compact).
+var compactView bool
+
+// dumpWords prints every word that passes the filters, in dictionary order, and exits (--dump).
+var dumpWords bool
+
+// curveName picks the roll delay preset (--curve); listCurves prints them and exits (--list-curves).
+var (
+	curveName  string
+	listCurves bool
+)
+
+// listThemes prints the --theme names with a color preview and exits (--list-themes).
+var listThemes bool
+
+// showFavorites prints the starred words and exits (--favorites).
+var showFavorites bool
+
+// themeName selects the TUI palette (--theme); see themes.
+var themeName string
+
+// autoAdvance starts the next round repeatDelayMs after each one stops, until a key is pressed (--auto).
+// With loopCount > 0 the TUI quits instead of starting round loopCount+1 (--loop-count).
+var (
+	autoAdvance   bool
+	repeatDelayMs int
+	loopCount     int
+)
+
+// freqColors colors each letter of the final word by how common it is in English (--freq-colors).
+var freqColors bool
+
+// scrambleWords shows each final word as an anagram until s reveals it (--scramble).
+var scrambleWords bool
+
+// reverseWords shows each final word spelled backwards; s toggles the forward spelling (--reverse).
+var reverseWords bool
+
+// noHint drops the key hint under the word (--no-hint); hintText replaces it (--hint).
+var (
+	noHint   bool
+	hintText string
+)
+
+// colorBlind is shorthand for --theme colorblind (--cb).
+var colorBlind bool
+
+// noMouse leaves the mouse to the terminal so scrollback keeps working (--no-mouse).
+var noMouse bool
+
+// bell rings the terminal bell when a roll stops (--bell).
+var bell bool
+
+// jsonOutput makes --once print JSON records instead of plain words (--json).
+var jsonOutput bool
+
+// formatText is a text/template rendering each --once word (--format), compiled into formatTemplate.
+var (
+	formatText     string
+	formatTemplate *template.Template
+)
+
+// verbose logs round starts, ticks and stops to stderr via debugLog (--verbose).
+var (
+	verbose  bool
+	debugLog = log.New(io.Discard, "gimme-five: ", log.Ltime|log.Lmicroseconds)
+)
+
+// quiet silences warnLog, which carries every non-fatal diagnostic (seed echo, fallbacks,
+// save failures), and copyWord's stderr fallback (--quiet). Errors that end the program are
+// still printed by main.
+var (
+	quiet   bool
+	warnLog = log.New(os.Stderr, "gimme-five: ", 0)
+)
+
+// vowels is the letter set counted as vowels by the vowel and consonant filters and --playable (--vowels).
+var vowels string
+
+// minVowels/maxVowels bound the vowel count of kept words; -1 means unbounded.
+var (
+	minVowels int
+	maxVowels int
+)
+
+// minConsonants/maxConsonants bound the number of distinct consonants in kept words; -1 means unbounded.
+var (
+	minConsonants int
+	maxConsonants int
+)
+
+// difficulty narrows the pool by letter frequency: "easy", "hard" or "" for uniform (--difficulty).
+var difficulty string
+
+// scrabbleBias makes high-Scrabble-score words tend to come up first (--scrabble-bias).
+var scrabbleBias bool
+
+// starters makes words covering many common letters, good Wordle openers, tend to come up first (--starters).
+var starters bool
+
+// noPluralS drops every word ending in s, a crude stand-in for plurals (--no-plural-s).
+// It takes non-plurals like "gloss" or "basis" with them.
+var noPluralS bool
+
+// playable drops words with no vowels or no consonants (abbreviations, Roman numerals...) (--playable).
+var playable bool
+
+// preferNew defers words already in the history file behind all unseen ones (--prefer-new);
+// seenWords is that history, loaded in run.
+var (
+	preferNew bool
+	seenWords map[string]bool
+)
+
+// fresh ignores the saved pool position and starts a new shuffle (--fresh).
+var fresh bool
+
+// daily makes the first reveal (or --once output) the date's word, the same for everyone (--daily).
+var daily bool
+
+// wordCase is how words are shown and printed: "upper", "lower" or "title" (--case).
+// Unset, the TUI shows uppercase and stdout gets lowercase. Words are always stored lowercase.
+var (
+	wordCase    string
+	displayCase string
+	printCase   string
+)
+
+// Roll delays (ms): accelerate, sustain, then slow to stop (roulette feel). This is the
+// default curve; --curve swaps in another preset at startup.
 var rollDelaysMs = []int{1000, 900, 800, 700, 600, 500, 400, 400, 400, 450, 550, 680, 800, 1000, 1500, 2000}
 
-const wordsPerRound = 16
+// effectiveDelays is the per-step curve (rollDelaysMs or buildDelays) divided by --speed, computed once at startup.
+var effectiveDelays []int
+
+// letterReveal lands on the final word at once and uncovers it a letter at a time (--letter-reveal),
+// each letter held for the matching step of revealDelays: the roll curve squeezed to wordLength steps.
+var (
+	letterReveal bool
+	revealDelays []int
+)
+
+// browse turns the roll into a manual browser: no ticks, ←/→ step through the round (--browse).
+var browse bool
+
+// boards is how many roulettes roll side by side, e.g. 4 for Quordle (--boards).
+var boards int
+
+// wordsPerRound is how many words flash before the roll stops (--rounds-length).
+var wordsPerRound int
+
+// noticeDuration is how long transient confirmations (e.g. "copied!") stay on screen.
+const noticeDuration = 1500 * time.Millisecond
+
+// maxHistory caps model.history; historyShown is how many recent words the view lists.
+const (
+	maxHistory   = 1000
+	historyShown = 5
+)
+
+func init() {
+	flag.IntVar(&wordLength, "length", 5, "number of letters in each word")
+	flag.BoolVar(&unicodeLetters, "unicode-letters", false, "accept accented and other non-ASCII letters (for non-English --dict lists)")
+	flag.StringVar(&dictPath, "dict", "", "load words from this file (- for stdin, with --once or --dump) instead of the embedded list")
+	flag.StringVar(&allowlistPath, "allowlist", "", "use only the words in this file (e.g. an official answer list)")
+	flag.StringVar(&blocklistPath, "blocklist", "", "file of words (one per line) to never pick")
+	flag.Var(&excludeWords, "exclude-word", "never pick this word (repeatable)")
+	flag.Int64Var(&seed, "seed", 0, "seed the RNG for a reproducible word sequence (default: time-based)")
+	flag.BoolVar(&once, "once", false, "print one random word and exit (no TUI)")
+	flag.BoolVar(&once, "1", false, "shorthand for --once")
+	flag.IntVar(&count, "count", 1, "number of distinct words to print with --once")
+	flag.BoolVar(&allowRepeats, "allow-repeats", false, "let --count exceed the dictionary size (words repeat across reshuffles)")
+	flag.BoolVar(&printHistory, "print-history", false, "print all revealed words to stdout on quit")
+	flag.BoolVar(&uniqueLetters, "unique-letters", false, "only use words with no repeated letters")
+	flag.StringVar(&includeLetters, "include", "", "only use words containing every one of these letters")
+	flag.StringVar(&excludeLetters, "exclude", "", "skip words containing any of these letters")
+	flag.StringVar(&presentLetters, "present", "", "letters known to be in the word (Wordle yellows); like --include")
+	flag.StringVar(&absentLetters, "absent", "", "letters ruled out (Wordle grays); like --exclude")
+	flag.StringVar(&pattern, "pattern", "", "positional mask, '_' = any letter (e.g. c_a_e)")
+	flag.StringVar(&regexFlag, "regex", "", "only use words matching this Go regular expression (unanchored)")
+	flag.StringVar(&startsWith, "starts-with", "", "only use words starting with this letter")
+	flag.StringVar(&endsWith, "ends-with", "", "only use words ending with this letter")
+	flag.Float64Var(&speed, "speed", 1.0, "roll speed multiplier (2 = twice as fast)")
+	flag.BoolVar(&letterReveal, "letter-reveal", false, "uncover the final word one letter at a time instead of rolling through words")
+	flag.BoolVar(&browse, "browse", false, "step through each round's words with ←/→ instead of rolling")
+	flag.IntVar(&boards, "boards", 1, "number of words revealed side by side each round (Quordle = 4)")
+	flag.IntVar(&wordsPerRound, "rounds-length", len(rollDelaysMs), "number of words that flash before the roll stops")
+	flag.BoolVar(&instant, "instant", false, "skip the roll animation and show the word immediately")
+	flag.BoolVar(&showStats, "stats", false, "print lifetime stats and exit")
+	flag.BoolVar(&showFavorites, "favorites", false, "print starred words and exit")
+	flag.BoolVar(&showSource, "source", false, "print the word list in use (a path or \"embedded\") and its word count, then exit")
+	flag.IntVar(&spread, "spread", 0, "with --once/--count, make each word differ from the previous one in at least this many letters")
+	flag.StringVar(&cpuProfile, "cpuprofile", "", "write a CPU profile to this file")
+	flag.BoolVar(&dryRun, "dry-run", false, "check the flags and word list, print a summary and exit")
+	flag.StringVar(&category, "category", "", "only use words from this bundled category (see --list-categories)")
+	flag.BoolVar(&listCategories, "list-categories", false, "print the bundled word categories and exit")
+	flag.BoolVar(&countWords, "count-words", false, "print how many words pass the filters and exit")
+	flag.IntVar(&idleTimeoutSecs, "idle-timeout", 0, "quit after this many seconds without input, e.g. for kiosks (0 = never)")
+	flag.BoolVar(&drama, "drama", false, "stretch the last few words of the roll for a slower, tenser landing")
+	flag.BoolVar(&showSeed, "show-seed", false, "show the seed and round number under the word, to share a reveal")
+	flag.BoolVar(&confirmQuit, "confirm-quit", false, "while rolling, q or Esc must be pressed twice to quit")
+	flag.BoolVar(&compactView, "compact", false, "show only the word on a single line (no hint, history or centering)")
+	flag.BoolVar(&dumpWords, "dump", false, "print every word that passes the filters, one per line, and exit")
+	flag.StringVar(&curveName, "curve", defaultCurve, "roll delay preset: default, slow, fast, snappy or suspense (see --list-curves)")
+	flag.StringVar(&defsPath, "dict-defs", "", "file of word<TAB>definition lines to show with d instead of the bundled ones")
+	flag.BoolVar(&listCurves, "list-curves", false, "print the roll delay presets and exit")
+	flag.BoolVar(&listThemes, "list-themes", false, "print the available themes with a color preview and exit")
+	flag.StringVar(&themeName, "theme", themes[0].name, "color theme: default, mono, solarized, highcontrast or colorblind")
+	flag.BoolVar(&colorBlind, "cb", false, "use the color-blind-friendly theme (same as --theme colorblind)")
+	flag.BoolVar(&autoAdvance, "auto", false, "start the next round automatically after each one stops (any key stops this)")
+	flag.IntVar(&loopCount, "loop-count", 0, "with --auto, quit after this many rounds (0 = keep going)")
+	flag.IntVar(&repeatDelayMs, "repeat-delay", 2000, "with --auto, milliseconds to show the word before the next round")
+	flag.IntVar(&rankMin, "rank-min", 0, "only use words at or after this position in the dictionary (for frequency-sorted lists)")
+	flag.IntVar(&rankMax, "rank-max", 0, "only use words at or before this position in the dictionary (for frequency-sorted lists)")
+	flag.BoolVar(&freqColors, "freq-colors", false, "color the final word's letters by English frequency (green common, red rare)")
+	flag.BoolVar(&scrambleWords, "scramble", false, "show the final word's letters scrambled; press s to reveal it")
+	flag.BoolVar(&reverseWords, "reverse", false, "show the final word spelled backwards; press s to flip it")
+	flag.BoolVar(&noHint, "no-hint", false, "hide the key hint under the word")
+	flag.StringVar(&hintText, "hint", "", "show this text instead of the default key hint")
+	flag.BoolVar(&noMouse, "no-mouse", false, "don't capture the mouse (scroll won't start a round)")
+	flag.BoolVar(&bell, "bell", false, "ring the terminal bell when the roll stops")
+	flag.BoolVar(&verbose, "verbose", false, "log roll timing to stderr")
+	flag.BoolVar(&quiet, "quiet", false, "don't print warnings or the seed to stderr (errors still are)")
+	flag.StringVar(&formatText, "format", "", "with --once, print each word with this Go template ({{.Word}}, {{.Upper}}, {{.Length}}, {{.Seed}})")
+	flag.BoolVar(&jsonOutput, "json", false, "with --once, print {\"word\",\"seed\",\"length\"} JSON instead of plain text")
+	flag.IntVar(&minVowels, "min-vowels", -1, "only use words with at least this many vowels (see --vowels)")
+	flag.IntVar(&maxVowels, "max-vowels", -1, "only use words with at most this many vowels (see --vowels)")
+	flag.StringVar(&vowels, "vowels", "aeiou", "letters that count as vowels (e.g. aeiouy, or aeiouáéíóú with --unicode-letters)")
+	flag.IntVar(&minConsonants, "min-consonants", -1, "only use words with at least this many distinct consonants")
+	flag.IntVar(&maxConsonants, "max-consonants", -1, "only use words with at most this many distinct consonants")
+	flag.StringVar(&difficulty, "difficulty", "", "easy (common letters) or hard (rare letters); default uniform")
+	flag.BoolVar(&scrabbleBias, "scrabble-bias", false, "favor words with high Scrabble scores")
+	flag.BoolVar(&starters, "starters", false, "favor strong Wordle openers: words covering many of the letters etaoinshr")
+	flag.BoolVar(&playable, "playable", false, "only use words with at least one vowel and one consonant")
+	flag.BoolVar(&noPluralS, "no-plural-s", false, "drop words ending in s (a rough plural filter that also drops words like gloss)")
+	flag.BoolVar(&fresh, "fresh", false, "start a new shuffle instead of resuming the previous session's")
+	flag.BoolVar(&preferNew, "prefer-new", false, "draw words not in the history file first; seen ones only once those run out")
+	flag.BoolVar(&daily, "daily", false, "reveal today's word, the same for everyone on the same date")
+	flag.StringVar(&wordCase, "case", "", "word case: upper, lower or title (default: upper in the TUI, lower when printing)")
+
+	flag.Usage = usage
+}
+
+// Bounds for the positional word length shorthand (gimme-five-go 6).
+const (
+	minLengthArg = 3
+	maxLengthArg = 15
+)
+
+// parseLengthArg reads the optional positional argument as the word length, a shorthand for --length.
+func parseLengthArg() error {
+	switch flag.NArg() {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("expected at most one argument (the word length), got %d", flag.NArg())
+	}
+	n, err := strconv.Atoi(flag.Arg(0))
+	if err != nil {
+		return fmt.Errorf("word length %q is not a number", flag.Arg(0))
+	}
+	if n < minLengthArg || n > maxLengthArg {
+		return fmt.Errorf("word length must be between %d and %d, got %d", minLengthArg, maxLengthArg, n)
+	}
+	if isFlagSet("length") {
+		return fmt.Errorf("give the word length either as --length or as an argument, not both")
+	}
+	wordLength = n
+	return nil
+}
+
+// normalizeFlags lowercases letter-based flags and rejects invalid combinations.
+func normalizeFlags() error {
+	if err := parseLengthArg(); err != nil {
+		return err
+	}
+	selectCurve(strings.ToLower(curveName))
+	includeLetters = strings.ToLower(includeLetters)
+	excludeLetters = strings.ToLower(excludeLetters)
+	pattern = strings.ToLower(pattern)
+	startsWith = strings.ToLower(startsWith)
+	endsWith = strings.ToLower(endsWith)
+	vowels = strings.ToLower(vowels)
+	if vowels == "" || !isLetters(vowels) {
+		return fmt.Errorf("--vowels must be a non-empty set of letters, got %q", vowels)
+	}
+	if err := mergeFeedback(); err != nil {
+		return err
+	}
+	if count < 1 {
+		return fmt.Errorf("--count must be at least 1")
+	}
+	if repeatDelayMs < 0 {
+		return fmt.Errorf("--repeat-delay must not be negative")
+	}
+	if idleTimeoutSecs < 0 {
+		return fmt.Errorf("--idle-timeout must not be negative")
+	}
+	idleTimeout = time.Duration(idleTimeoutSecs) * time.Second
+	if dictPath == stdinDict && !once && !dumpWords && !countWords && !showSource && !dryRun {
+		return fmt.Errorf("--dict - reads words from stdin, which the TUI needs for keys; use it with --once or --dump")
+	}
+	if isFlagSet("repeat-delay") && !autoAdvance {
+		return fmt.Errorf("--repeat-delay only applies with --auto")
+	}
+	if loopCount < 0 {
+		return fmt.Errorf("--loop-count must not be negative")
+	}
+	if isFlagSet("loop-count") && !autoAdvance {
+		return fmt.Errorf("--loop-count only applies with --auto")
+	}
+	if autoAdvance && browse {
+		return fmt.Errorf("--auto and --browse are mutually exclusive")
+	}
+	if letterReveal && (browse || scrambleWords || reverseWords) {
+		return fmt.Errorf("--letter-reveal can't be combined with --browse, --scramble or --reverse")
+	}
+	if (scrambleWords || reverseWords) && browse {
+		return fmt.Errorf("--scramble and --reverse can't be combined with --browse")
+	}
+	if scrambleWords && reverseWords {
+		return fmt.Errorf("--scramble and --reverse are mutually exclusive")
+	}
+	if spread < 0 || spread > wordLength {
+		return fmt.Errorf("--spread must be between 0 and the word length (%d)", wordLength)
+	}
+	if spread > 0 && !once {
+		return fmt.Errorf("--spread only applies with --once")
+	}
+	if formatText != "" {
+		if jsonOutput {
+			return fmt.Errorf("--format and --json are mutually exclusive")
+		}
+		t, err := parseFormat(formatText)
+		if err != nil {
+			return err
+		}
+		formatTemplate = t
+	}
+	if quiet && verbose {
+		return fmt.Errorf("--quiet and --verbose are mutually exclusive")
+	}
+	if noHint && hintText != "" {
+		return fmt.Errorf("--hint and --no-hint are mutually exclusive")
+	}
+	if rankMin < 0 || rankMax < 0 {
+		return fmt.Errorf("--rank-min and --rank-max must not be negative")
+	}
+	if rankMin > 0 && rankMax > 0 && rankMin >= rankMax {
+		return fmt.Errorf("--rank-min (%d) must be less than --rank-max (%d)", rankMin, rankMax)
+	}
+	if allowlistPath != "" && dictPath != "" {
+		return fmt.Errorf("--allowlist and --dict are mutually exclusive")
+	}
+	switch wordCase {
+	case "":
+		displayCase, printCase = "upper", "lower"
+	case "upper", "lower", "title":
+		displayCase, printCase = wordCase, wordCase
+	default:
+		return fmt.Errorf("--case must be upper, lower or title, got %q", wordCase)
+	}
+	if colorBlind {
+		if isFlagSet("theme") && themeName != "colorblind" {
+			return fmt.Errorf("--cb and --theme %s are mutually exclusive", themeName)
+		}
+		themeName = "colorblind"
+	}
+	if _, ok := themeByName(themeName); !ok {
+		return fmt.Errorf("unknown --theme %q", themeName)
+	}
+	switch difficulty {
+	case "", "easy", "hard":
+	default:
+		return fmt.Errorf("--difficulty must be easy or hard, got %q", difficulty)
+	}
+	if speed <= 0 {
+		return fmt.Errorf("--speed must be greater than 0")
+	}
+	if boards < 1 {
+		return fmt.Errorf("--boards must be at least 1")
+	}
+	if wordsPerRound < 1 {
+		return fmt.Errorf("--rounds-length must be at least 1")
+	}
+	if daily && isFlagSet("seed") {
+		return fmt.Errorf("--daily derives its seed from the date; drop --seed")
+	}
+	if daily && once && count > 1 {
+		return fmt.Errorf("--daily picks a single word; drop --count")
+	}
+	if minVowels >= 0 && maxVowels >= 0 && minVowels > maxVowels {
+		return fmt.Errorf("--min-vowels %d is greater than --max-vowels %d", minVowels, maxVowels)
+	}
+	if minConsonants >= 0 && maxConsonants >= 0 && minConsonants > maxConsonants {
+		return fmt.Errorf("--min-consonants %d is greater than --max-consonants %d", minConsonants, maxConsonants)
+	}
+	if regexFlag != "" {
+		re, err := regexp.Compile(regexFlag)
+		if err != nil {
+			return errorOf(gimme.ErrBadPattern, "bad --regex: %w", err)
+		}
+		wordRegexp = re
+	}
+	if err := checkLetterFlag("starts-with", startsWith); err != nil {
+		return err
+	}
+	if err := checkLetterFlag("ends-with", endsWith); err != nil {
+		return err
+	}
+	if pattern != "" {
+		if err := gimme.CheckPattern(pattern, wordLength); err != nil {
+			return fmt.Errorf("--%w", err) // "--pattern ..."; still matches gimme.ErrBadPattern
+		}
+		if !isLetters(strings.ReplaceAll(pattern, "_", "")) {
+			return errorOf(gimme.ErrBadPattern, "--pattern %q may only contain letters and '_'", pattern)
+		}
+	}
+	return nil
+}
+
+// isFlagSet reports whether the named flag was given on the command line.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// loadWords reads one word per line from r, keeping (lowercased) the words that pass keepWord.
+// Duplicates (e.g. "Crane" and "crane") are kept once, at their first position, so order stays stable.
+// Blank lines and lines starting with # are skipped, so word files can carry comments.
+func loadWords(r io.Reader) []string {
+	hint := sizeHint(r)
+	words := make([]string, 0, hint)
+	seen := make(map[string]struct{}, hint)
+	err := eachLine(r, func(raw []byte) {
+		// Check length on the reader's bytes so lines of other lengths (most of them) are never copied.
+		line := bytes.TrimSpace(raw)
+		if isCommentOrBlank(line) || utf8.RuneCount(line) != wordLength {
+			return
+		}
+		w := string(line)
+		if !isLetters(w) {
+			return
+		}
+		w = strings.ToLower(w) // returns w itself when it's already lowercase ASCII
+		if _, dup := seen[w]; dup {
+			return
+		}
+		seen[w] = struct{}{}
+		dictRanked++
+		if !inRankRange(dictRanked) || !keepWord(w) {
+			return
+		}
+		words = append(words, w)
+	})
+	if err != nil {
+		warnLog.Printf("reading words: %v (the list may be incomplete)", err)
+	}
+	return words
+}
+
+// maxLineLen bounds the lines eachLine passes on. Longer ones can't hold a word and are
+// skipped whole, so binary data or a list without newlines can't cut a read short.
+const maxLineLen = 4096
+
+// eachLine calls fn with every line of r up to maxLineLen bytes, newline included; the slice is
+// only valid during the call. Unlike bufio.Scanner, an overlong line is dropped, not fatal.
+func eachLine(r io.Reader, fn func(line []byte)) error {
+	br := bufio.NewReaderSize(r, maxLineLen)
+	for {
+		line, err := br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			for err == bufio.ErrBufferFull {
+				_, err = br.ReadSlice('\n')
+			}
+		} else if len(line) > 0 {
+			fn(line)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// isCommentOrBlank reports whether a trimmed word-file line carries no word: empty, or a
+// "# ..." comment, so lists can be annotated.
+func isCommentOrBlank(line []byte) bool {
+	return len(line) == 0 || line[0] == '#'
+}
+
+// sizeHint guesses how many words loadWords will keep from r, to pre-size its slice and map:
+// about one per 128 bytes of a general dictionary. It is 0 when r's size is unknown.
+func sizeHint(r io.Reader) int {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return v.Len() / 128
+	case *os.File:
+		if info, err := v.Stat(); err == nil && info.Mode().IsRegular() {
+			return int(info.Size() / 128)
+		}
+	}
+	return 0
+}
+
+// inRankRange reports whether the rank-th word of the dictionary is within --rank-min/--rank-max.
+func inRankRange(rank int) bool {
+	return rank >= rankMin && (rankMax == 0 || rank <= rankMax)
+}
+
+// checkRankRange rejects a --rank-min or --rank-max beyond the end of the loaded dictionary.
+func checkRankRange() error {
+	source := dictSource
+	if source == "" {
+		source = "the embedded word list"
+	}
+	if rankMin > dictRanked {
+		return fmt.Errorf("--rank-min %d is past the %d %d-letter words in %s", rankMin, dictRanked, wordLength, source)
+	}
+	if rankMax > dictRanked {
+		return fmt.Errorf("--rank-max %d is past the %d %d-letter words in %s", rankMax, dictRanked, wordLength, source)
+	}
+	return nil
+}
+
+// sourceLabel names the loaded word list for people: its path, or "embedded" (also after a --dict fallback).
+func sourceLabel() string {
+	if dictSource == "" {
+		return "embedded"
+	}
+	return dictSource
+}
+
+// checkWords rejects an empty word list before anything tries to draw from it, with the
+// gimme.CheckWords error (gimme.ErrEmptyDictionary or gimme.ErrNoMatch) as its kind.
+func checkWords(words []string) error {
+	err := gimme.CheckWords(words, dictRanked)
+	if err == nil {
+		return nil
+	}
+	source := "the embedded word list"
+	switch {
+	case allowlistPath != "":
+		source = "allowlist " + allowlistPath
+	case dictSource != "":
+		source = dictSource
+	}
+	if errors.Is(err, gimme.ErrEmptyDictionary) {
+		return errorOf(err, "no %d-letter words in %s", wordLength, source)
+	}
+	return errorOf(err, "no %d-letter words in %s match the given filters", wordLength, source)
+}
+
+// mergeFeedback adds --present to includeLetters and --absent to excludeLetters, rejecting
+// non-letters and any letter that ends up both required and excluded.
+func mergeFeedback() error {
+	presentLetters = strings.ToLower(presentLetters)
+	absentLetters = strings.ToLower(absentLetters)
+	if presentLetters != "" && !isLetters(presentLetters) {
+		return fmt.Errorf("--present must be letters, got %q", presentLetters)
+	}
+	if absentLetters != "" && !isLetters(absentLetters) {
+		return fmt.Errorf("--absent must be letters, got %q", absentLetters)
+	}
+	includeLetters += presentLetters
+	excludeLetters += absentLetters
+	for _, c := range includeLetters {
+		if strings.ContainsRune(excludeLetters, c) {
+			return fmt.Errorf("letter %q is both required (--present/--include) and ruled out (--absent/--exclude)", c)
+		}
+	}
+	return nil
+}
+
+// checkLetterFlag requires a single-letter flag value to be empty or one letter as isLetters
+// sees it: a–z or A–Z, or any Unicode letter with --unicode-letters.
+func checkLetterFlag(name, v string) error {
+	if v != "" && (letterCount(v) != 1 || !isLetters(v)) {
+		return fmt.Errorf("--%s must be a single letter, got %q", name, v)
+	}
+	return nil
+}
+
+// keepWord applies the optional load-time filters to a valid, lowercased word.
+func keepWord(w string) bool {
+	if _, ok := blocked[w]; ok {
+		return false
+	}
+	if _, ok := categoryWords[w]; categoryWords != nil && !ok {
+		return false
+	}
+	if uniqueLetters && !hasUniqueLetters(w) {
+		return false
+	}
+	if noPluralS && strings.HasSuffix(w, "s") {
+		return false
+	}
+	if !containsAll(w, includeLetters) || strings.ContainsAny(w, excludeLetters) {
+		return false
+	}
+	if pattern != "" && !matchesPattern(w, pattern) {
+		return false
+	}
+	if wordRegexp != nil && !wordRegexp.MatchString(w) {
+		return false
+	}
+	if !patternFixes(0) && !strings.HasPrefix(w, startsWith) {
+		return false
+	}
+	if !patternFixes(wordLength-1) && !strings.HasSuffix(w, endsWith) {
+		return false
+	}
+	if playable {
+		if v := countVowels(w); v == 0 || v == letterCount(w) {
+			return false
+		}
+	}
+	if minVowels >= 0 || maxVowels >= 0 {
+		v := countVowels(w)
+		if (minVowels >= 0 && v < minVowels) || (maxVowels >= 0 && v > maxVowels) {
+			return false
+		}
+	}
+	if minConsonants >= 0 || maxConsonants >= 0 {
+		c := countDistinctConsonants(w)
+		if (minConsonants >= 0 && c < minConsonants) || (maxConsonants >= 0 && c > maxConsonants) {
+			return false
+		}
+	}
+	return true
+}
+
+func isVowel(c rune) bool {
+	return strings.ContainsRune(vowels, c)
+}
+
+func countVowels(s string) int {
+	n := 0
+	for _, c := range s {
+		if isVowel(c) {
+			n++
+		}
+	}
+	return n
+}
+
+// countDistinctConsonants counts the different non-vowel letters in s: 2 for "mamma", 3 for "crane".
+func countDistinctConsonants(s string) int {
+	seen := make(map[rune]bool, len(s))
+	for _, c := range s {
+		if !isVowel(c) {
+			seen[c] = true
+		}
+	}
+	return len(seen)
+}
+
+// patternFixes reports whether --pattern pins a letter at position i.
+func patternFixes(i int) bool {
+	p := []rune(pattern)
+	return i < len(p) && p[i] != '_'
+}
+
+// matchesPattern reports whether word has pattern's letters at the same positions; '_' matches anything.
+func matchesPattern(word, pattern string) bool {
+	w, p := []rune(word), []rune(pattern)
+	if len(w) != len(p) {
+		return false
+	}
+	for i := range p {
+		if p[i] != '_' && p[i] != w[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAll reports whether every letter of letters appears in s.
+func containsAll(s, letters string) bool {
+	for _, c := range letters {
+		if !strings.ContainsRune(s, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// loadWordSet reads a newline-separated file into a set of lowercased, trimmed words.
+func loadWordSet(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readWordSet(f)
+}
+
+// readWordSet reads one word per line from r into a lowercased set, skipping blank and # comment lines.
+func readWordSet(r io.Reader) (map[string]struct{}, error) {
+	set := make(map[string]struct{})
+	err := eachLine(r, func(raw []byte) {
+		if line := bytes.TrimSpace(raw); !isCommentOrBlank(line) {
+			set[strings.ToLower(string(line))] = struct{}{}
+		}
+	})
+	return set, err
+}
+
+// loadDictionary loads from allowlistPath or dictPath when set. An unreadable allowlist
+// is an error; an unreadable dict falls back to the embedded list. --dict - reads standard input.
+func loadDictionary() ([]string, error) {
+	if allowlistPath != "" {
+		f, err := os.Open(allowlistPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading allowlist: %w", err)
+		}
+		defer f.Close()
+		dictSource = allowlistPath
+		return loadWords(f), nil
+	}
+	if dictPath == stdinDict {
+		dictSource = "stdin"
+		return loadWords(os.Stdin), nil
+	}
+	if dictPath != "" {
+		f, err := os.Open(dictPath)
+		if err == nil {
+			defer f.Close()
+			dictSource = dictPath
+			return loadWords(f), nil
+		}
+		warnLog.Printf("%v; using embedded word list", err)
+	}
+	return loadWords(bytes.NewReader(wordsAlphaTxt)), nil
+}
+
+// buildDelays stretches or squeezes rollDelaysMs to n steps by linear interpolation,
+// keeping the accelerate → sustain → decelerate shape for any round length.
+func buildDelays(n int) []int {
+	last := len(rollDelaysMs) - 1
+	if n == 1 {
+		return []int{rollDelaysMs[last]}
+	}
+	out := make([]int, n)
+	for i := range out {
+		pos := float64(i*last) / float64(n-1)
+		lo := int(pos)
+		hi := min(lo+1, last)
+		frac := pos - float64(lo)
+		out[i] = int(float64(rollDelaysMs[lo])*(1-frac) + float64(rollDelaysMs[hi])*frac)
+	}
+	return out
+}
+
+// scaleDelays divides each delay by speed.
+func scaleDelays(delays []int, speed float64) []int {
+	out := make([]int, len(delays))
+	for i, d := range delays {
+		out[i] = int(float64(d) / speed)
+	}
+	return out
+}
+
+// applyCase renders a stored (lowercase) word in the given case style. Mapping is rune by
+// rune and locale-neutral (a Turkish i still becomes I), so the word keeps its letter count;
+// title case uses the Unicode title form of the first letter (ǆ → ǅ, not Ǆ).
+func applyCase(w, style string) string {
+	switch style {
+	case "lower":
+		return w
+	case "title":
+		if w == "" {
+			return w
+		}
+		c, size := utf8.DecodeRuneInString(w)
+		return string(unicode.ToTitle(c)) + w[size:]
+	default:
+		return strings.ToUpper(w)
+	}
+}
+
+// displayWord is w as the TUI shows it (--case, uppercase by default). Every on-screen word
+// goes through it, so per-letter rendering (--freq-colors, --letter-reveal) sees the same runes.
+func displayWord(w string) string {
+	return applyCase(w, displayCase)
+}
+
+// isLetters reports whether s is all letters: a–z and A–Z, or any Unicode letter with --unicode-letters.
+func isLetters(s string) bool {
+	if !unicodeLetters {
+		return isAlpha(s)
+	}
+	for _, c := range s {
+		if !unicode.IsLetter(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// letterCount is the length of s in letters (runes, not bytes), so "cañón" has five.
+func letterCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+func isAlpha(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func hasUniqueLetters(s string) bool {
+	seen := make(map[rune]bool, len(s))
+	for _, c := range s {
+		if seen[c] {
+			return false
+		}
+		seen[c] = true
+	}
+	return true
+}
+
+// newPool shuffles fiveLetterWords using r (seeded from the session seed in main),
+// biased by poolWeights when a bias flag is set and with seen words last under --prefer-new.
+func newPool(r *rand.Rand) *gimme.Picker {
+	var p *gimme.Picker
+	if weights := poolWeights(fiveLetterWords); weights != nil {
+		p = gimme.NewWeightedPicker(fiveLetterWords, weights, r)
+	} else {
+		p = gimme.NewPickerWithRand(fiveLetterWords, r)
+	}
+	if preferNew && len(seenWords) > 0 {
+		p.Defer(seenFlags(fiveLetterWords, seenWords))
+	}
+	return p
+}
+
+// --- Model & messages ---
+
+// gameState is where a round is: flashing words, or landed on the final one.
+type gameState int
+
+const (
+	stateRolling gameState = iota
+	stateStopped
+)
+
+type rollTickMsg struct {
+	t     time.Time
+	board int
+	seq   int
+}
+
+// revealTickMsg uncovers the next letter of board's word (--letter-reveal).
+type revealTickMsg struct {
+	t     time.Time
+	board int
+	seq   int
+}
+
+// startRoundMsg starts the next round. auto ones are scheduled by --auto and dropped once it's
+// cancelled or a newer one has been scheduled (seq != autoSeq).
+type startRoundMsg struct {
+	auto bool
+	seq  int
+}
+type copiedMsg struct{ err error }
+type idleTickMsg struct{ t time.Time }
+type clearNoticeMsg struct{ seq int }
 
-func init() {
-	sc := bufio.NewScanner(bytes.NewReader(wordsAlphaTxt))
-	for sc.Scan() {
-		w := strings.TrimSpace(sc.Text())
-		if len(w) == 5 && isAlpha(w) {
-			fiveLetterWords = append(fiveLetterWords, strings.ToLower(w))
-		}
+type model struct {
+	words      []string      // all 5-letter words
+	pool       *gimme.Picker // shuffled indices
+	state      gameState     // stateStopped once every roll has stopped
+	rolls      []roll        // one per board (--boards)
+	history    []string      // revealed words, oldest first (capped at maxHistory)
+	notice     string        // transient confirmation/error under the word
+	noticeSeq  int           // bumped per notice so older clear timers are ignored
+	paused     bool          // roll frozen on the current word (space)
+	stats      *stats        // lifetime stats, saved on quit
+	dailyIdx   int           // word the first round lands on with --daily (-1 = none)
+	styles     styles        // built from the --theme palette
+	width      int           // terminal size from tea.WindowSizeMsg (80x12 until the first one)
+	height     int
+	replaying  bool            // re-spinning the same roundIdx (r); not recorded again
+	showHelp   bool            // ? overlay is up; the next key closes it
+	showInfo   bool            // i overlay (session summary) is up; the next key closes it
+	played     int             // rounds completed this session, not counting replays
+	seenWords  map[string]bool // distinct words revealed this session (history is capped, this isn't)
+	scrambler  *rand.Rand      // shuffles letters for --scramble (nil otherwise)
+	showDefs   bool            // definitions of the final words are shown under them (d)
+	hidden     bool            // final words show as their puzzle form until s (--scramble, --reverse)
+	auto       bool            // --auto still on; the first key press turns it off
+	autoSeq    int             // bumped per scheduled --auto round so superseded ones are ignored
+	freqColors bool            // --freq-colors, unless the theme has no colors
+	rollStart  time.Time       // when the current roll started
+	elapsed    time.Duration   // rollStart to the latest tick; frozen once the roll stops
+	themeIdx   int             // index into themes, cycled with t
+	themeSet   bool            // t was pressed, so themeIdx is saved on quit
+	reshuffles int             // R presses so far; seeds their shuffles when the session is seeded
+	lastActive time.Time       // last key, mouse event or roll stop, for --idle-timeout
+	quitArmed  bool            // q/Esc was pressed mid-roll with --confirm-quit; a second one quits
+	round      int             // rounds dealt this session (not counting replays), for --show-seed
+	resumed    bool            // the pool continues a saved one, so the seed alone doesn't reproduce it
+}
+
+func initialModel(rng *rand.Rand) model {
+	rolls := make([]roll, boards)
+	for i := range rolls {
+		rolls[i].step = -1
+	}
+	m := model{
+		words:      fiveLetterWords,
+		pool:       newPool(rng),
+		state:      stateRolling,
+		rolls:      rolls,
+		stats:      loadStats(),
+		dailyIdx:   -1,
+		auto:       autoAdvance,
+		width:      80,
+		height:     12,
+		lastActive: time.Now(),
+		seenWords:  make(map[string]bool),
 	}
+	m.setTheme(themeIndex(themeName))
+	if scrambleWords {
+		m.scrambler = rand.New(rand.NewSource(rng.Int63()))
+	}
+	return m
 }
 
-func isAlpha(s string) bool {
-	for _, c := range s {
-		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
-			return false
-		}
+// setTheme switches to themes[i] and rebuilds the styles (plain text when color is unavailable).
+func (m *model) setTheme(i int) {
+	m.themeIdx = i
+	th := themes[i]
+	if !colorEnabled() {
+		th = plainTheme
 	}
-	return true
+	m.styles = newStyles(th)
+	if compactView {
+		m.styles.rolling = m.styles.rolling.UnsetMargins()
+		m.styles.final = m.styles.final.UnsetMargins()
+	}
+	m.freqColors = freqColors && colorEnabled() && !th.mono
 }
 
-// pool of indices into fiveLetterWords; shuffled once, consumed in order per round.
-type pool struct {
-	indices []int
-	cursor  int
+func (m model) Init() tea.Cmd {
+	// Trigger round start on first frame so we can set roundIdx and schedule first tick.
+	start := tea.Tick(0, func(time.Time) tea.Msg { return startRoundMsg{} })
+	if idleTimeout > 0 {
+		return tea.Batch(start, idleCheck(idleTimeout))
+	}
+	return start
+}
+
+// idleCheck schedules the next --idle-timeout check after d.
+func idleCheck(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg { return idleTickMsg{t} })
 }
 
-func newPool() *pool {
-	n := len(fiveLetterWords)
-	idx := make([]int, n)
-	for i := 0; i < n; i++ {
-		idx[i] = i
+// beginRound prepares the next wordsPerRound indices for each board and returns the first tick Cmds.
+// Draw handles word lists smaller than a round (e.g. after heavy filtering).
+func (m *model) beginRound() tea.Cmd {
+	for i := range m.rolls {
+		m.rolls[i].roundIdx = m.pool.Draw(wordsPerRound)
 	}
-	rand.Shuffle(n, func(i, j int) { idx[i], idx[j] = idx[j], idx[i] })
-	return &pool{indices: idx, cursor: 0}
+	if m.dailyIdx >= 0 {
+		m.rolls[0].roundIdx[wordsPerRound-1] = m.dailyIdx
+		m.dailyIdx = -1
+	}
+	m.round++
+	m.replaying = false
+	return m.startRoll()
 }
 
-func (p *pool) ensureCapacity(need int) {
-	remaining := len(p.indices) - p.cursor
-	if remaining >= need {
-		return
+// startRoll spins every board's current roundIdx from its first word.
+func (m *model) startRoll() tea.Cmd {
+	m.paused = false
+	if browse {
+		for i := range m.rolls {
+			m.rolls[i].step = 0
+			m.rolls[i].state = stateStopped
+		}
+		m.state = stateStopped
+		return nil
+	}
+	if instant {
+		return m.finishRound()
 	}
-	// Refill: new shuffle and reset cursor
-	n := len(fiveLetterWords)
-	idx := make([]int, n)
-	for i := 0; i < n; i++ {
-		idx[i] = i
+	m.state = stateRolling
+	m.rollStart, m.elapsed = time.Now(), 0
+	debugLog.Printf("round start: %d steps, %d boards", wordsPerRound, len(m.rolls))
+	cmds := make([]tea.Cmd, len(m.rolls))
+	for i := range m.rolls {
+		m.rolls[i].step = 0
+		if letterReveal {
+			m.rolls[i].step = wordsPerRound - 1
+			m.rolls[i].revealed = 0
+		}
+		m.rolls[i].state = stateRolling
+		cmds[i] = m.scheduleTick(i)
 	}
-	rand.Shuffle(n, func(i, j int) { idx[i], idx[j] = idx[j], idx[i] })
-	p.indices = idx
-	p.cursor = 0
+	return tea.Batch(cmds...)
 }
 
-func (p *pool) take(n int) []int {
-	p.ensureCapacity(n)
-	out := make([]int, n)
-	copy(out, p.indices[p.cursor:p.cursor+n])
-	p.cursor += n
+// scheduleTick returns the tick that ends board's current step (or letter, with --letter-reveal) after its delay.
+func (m *model) scheduleTick(board int) tea.Cmd {
+	r := &m.rolls[board]
+	r.tickSeq++
+	seq := r.tickSeq
+	if letterReveal {
+		delay := r.revealDelay(board)
+		debugLog.Printf("board %d letter %d: next tick in %dms", board, r.revealed, delay)
+		return tea.Tick(time.Duration(delay)*time.Millisecond, func(t time.Time) tea.Msg {
+			return revealTickMsg{t: t, board: board, seq: seq}
+		})
+	}
+	delay := r.delay(board)
+	debugLog.Printf("board %d step %d: next tick in %dms", board, r.step, delay)
+	return tea.Tick(time.Duration(delay)*time.Millisecond, func(t time.Time) tea.Msg {
+		return rollTickMsg{t: t, board: board, seq: seq}
+	})
+}
+
+// currentWord is the word the first board shows.
+func (m model) currentWord() string {
+	if len(m.rolls) == 0 {
+		return ""
+	}
+	return m.rolls[0].word(m.words)
+}
+
+// currentWords is the word each board shows, in board order.
+func (m model) currentWords() []string {
+	out := make([]string, len(m.rolls))
+	for i, r := range m.rolls {
+		out[i] = r.word(m.words)
+	}
 	return out
 }
 
-// --- Model & messages ---
+// browseStep moves every board one word forward or back, wrapping at the ends of the round.
+func (m *model) browseStep(forward bool) {
+	for i := range m.rolls {
+		r := &m.rolls[i]
+		if forward {
+			r.step = (r.step + 1) % wordsPerRound
+		} else {
+			r.step = (r.step - 1 + wordsPerRound) % wordsPerRound
+		}
+	}
+}
 
-type rollTickMsg struct{ t time.Time }
-type startRoundMsg struct{}
+// reshuffle replaces the pool with a brand-new shuffle (R). In a seeded session (--seed, --daily)
+// the n-th reshuffle is seeded with seed+n, so a replayed session reshuffles the same way;
+// otherwise it uses fresh, time-based randomness.
+func (m *model) reshuffle() {
+	m.reshuffles++
+	src := time.Now().UnixNano()
+	if daily || isFlagSet("seed") {
+		src = seed + int64(m.reshuffles)
+	}
+	m.pool = newPool(rand.New(rand.NewSource(src)))
+	debugLog.Printf("reshuffle %d", m.reshuffles)
+}
 
-type model struct {
-	words    []string // all 5-letter words
-	pool     *pool    // shuffled indices
-	state    string   // "rolling" | "stopped"
-	roundIdx []int    // indices for current round (len 16)
-	step     int      // 0..15 during roll
+// stepBack moves every rolling board (or, in browse mode, every board) back one word, never
+// before the first; with --letter-reveal it hides the last uncovered letter instead. A running
+// roll is paused so the earlier word stays up, and space resumes from it.
+func (m *model) stepBack() {
+	if m.state != stateRolling && !browse {
+		return
+	}
+	if m.state == stateRolling && !m.paused {
+		m.paused = true
+		for i := range m.rolls {
+			m.rolls[i].tickSeq++ // drop the tick already in flight
+		}
+	}
+	for i := range m.rolls {
+		r := &m.rolls[i]
+		switch {
+		case r.state != stateRolling && !browse:
+		case letterReveal:
+			r.revealed = max(r.revealed-1, 0)
+		default:
+			r.step = max(r.step-1, 0)
+		}
+	}
 }
 
-func initialModel() model {
-	return model{
-		words:    fiveLetterWords,
-		pool:     newPool(),
-		state:    "rolling",
-		roundIdx: nil,
-		step:     -1,
+// skipToEnd lands a running (or paused) roll on its final words at once (Enter or . while
+// rolling). Bumping every tickSeq drops the ticks in flight, so none of them resumes the animation.
+func (m *model) skipToEnd() tea.Cmd {
+	if m.state != stateRolling {
+		return nil
+	}
+	for i := range m.rolls {
+		m.rolls[i].tickSeq++
 	}
+	m.paused = false
+	return m.finishRound()
 }
 
-func (m model) Init() tea.Cmd {
-	// Trigger round start on first frame so we can set roundIdx and schedule first tick.
-	return tea.Tick(0, func(time.Time) tea.Msg { return startRoundMsg{} })
+// allStopped reports whether every board has landed.
+func (m model) allStopped() bool {
+	for _, r := range m.rolls {
+		if r.state != stateStopped {
+			return false
+		}
+	}
+	return true
 }
 
-// beginRound prepares the next 16 indices and returns the first tick Cmd.
-func (m *model) beginRound() tea.Cmd {
-	m.pool.ensureCapacity(wordsPerRound)
-	m.roundIdx = m.pool.take(wordsPerRound)
-	m.step = 0
-	m.state = "rolling"
-	return tea.Tick(time.Duration(rollDelaysMs[0])*time.Millisecond, func(t time.Time) tea.Msg {
-		return rollTickMsg{t: t}
-	})
+// finishRound lands every board on its last word and records them, returning any stop-time Cmd.
+func (m *model) finishRound() tea.Cmd {
+	for i := range m.rolls {
+		m.rolls[i].step = wordsPerRound - 1
+		m.rolls[i].state = stateStopped
+	}
+	m.state = stateStopped
+	m.lastActive = time.Now() // idle time runs from the landing, not from the last key
+	debugLog.Printf("round stop: %s", strings.Join(m.currentWords(), " "))
+	if m.replaying {
+		m.replaying = false
+		return nil
+	}
+	for _, w := range m.currentWords() {
+		m.recordHistory(w)
+	}
+	if m.scrambler != nil || reverseWords {
+		for i, w := range m.currentWords() {
+			if reverseWords {
+				m.rolls[i].puzzle = reverseString(w)
+			} else {
+				m.rolls[i].puzzle = scramble(w, m.scrambler)
+			}
+		}
+		m.hidden = true
+	}
+	m.stats.recordRound(time.Now())
+	m.played++
+	var cmds []tea.Cmd
+	if bell {
+		cmds = append(cmds, ringBell)
+	}
+	if m.auto {
+		m.autoSeq++
+		seq := m.autoSeq
+		cmds = append(cmds, tea.Tick(time.Duration(repeatDelayMs)*time.Millisecond, func(time.Time) tea.Msg {
+			return startRoundMsg{auto: true, seq: seq}
+		}))
+	}
+	return tea.Batch(cmds...)
 }
 
-func (m model) currentWord() string {
-	if len(m.roundIdx) == 0 || m.step < 0 {
-		return ""
+// ringBell writes BEL to the terminal; it has no visible output, so the frame is unaffected.
+func ringBell() tea.Msg {
+	os.Stdout.WriteString("\a")
+	return nil
+}
+
+// recordHistory appends a revealed word, dropping the oldest beyond maxHistory.
+func (m *model) recordHistory(w string) {
+	m.seenWords[w] = true
+	m.history = append(m.history, w)
+	if len(m.history) > maxHistory {
+		m.history = m.history[len(m.history)-maxHistory:]
 	}
-	idx := m.roundIdx[m.step]
-	if idx >= len(m.words) {
-		return ""
+}
+
+// setNotice shows text under the word and returns a Cmd that clears it after noticeDuration.
+func (m *model) setNotice(text string) tea.Cmd {
+	m.notice = text
+	m.noticeSeq++
+	seq := m.noticeSeq
+	return tea.Tick(noticeDuration, func(time.Time) tea.Msg { return clearNoticeMsg{seq: seq} })
+}
+
+// copyWord writes word to the system clipboard, falling back to stderr when no clipboard is
+// available (except with --quiet, where only the notice says it failed).
+func copyWord(word string) tea.Cmd {
+	return func() tea.Msg {
+		err := clipboard.WriteAll(word)
+		if err != nil && !quiet {
+			fmt.Fprintln(os.Stderr, word)
+		}
+		return copiedMsg{err: err}
 	}
-	return m.words[idx]
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case startRoundMsg:
+		if msg.auto && (!m.auto || msg.seq != m.autoSeq || m.state != stateStopped) {
+			return m, nil
+		}
+		if msg.auto && loopCount > 0 && m.played >= loopCount {
+			// The last round has had its repeat delay on screen; leave like q would.
+			return m, tea.Quit
+		}
 		return m, m.beginRound()
 
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case idleTickMsg:
+		// A running roll is being watched; check again once it could have been idle long enough.
+		if m.state == stateRolling && !m.paused {
+			return m, idleCheck(idleTimeout)
+		}
+		if idle := msg.t.Sub(m.lastActive); idle < idleTimeout {
+			return m, idleCheck(idleTimeout - idle)
+		}
+		debugLog.Printf("idle for %s, quitting", idleTimeout)
+		return m, tea.Quit
+
 	case tea.KeyMsg:
+		m.lastActive = time.Now()
+		m.auto = false
+		if msg.String() == "ctrl+c" {
+			// Always quits (and saves), whatever overlay or prompt is up.
+			return m, tea.Quit
+		}
+		if m.showHelp || m.showInfo {
+			m.showHelp, m.showInfo = false, false
+			return m, nil
+		}
+		if m.quitArmed {
+			// The prompt takes the next key: q or Esc quits, anything else just cancels.
+			m.quitArmed = false
+			if k := msg.String(); k != "q" && k != "esc" {
+				m.notice = ""
+				return m, nil
+			}
+			return m, tea.Quit
+		}
 		switch msg.String() {
+		case "?":
+			m.showHelp = true
+			return m, nil
+		case "i":
+			m.showInfo = true
+			return m, nil
 		case "q", "esc":
+			if confirmQuit && m.state == stateRolling {
+				m.quitArmed = true
+				return m, m.setNotice("press q again to quit")
+			}
 			return m, tea.Quit
 		case "enter":
-			if m.state == "stopped" {
+			if m.state == stateStopped {
 				cmd := m.beginRound()
 				return m, cmd
 			}
+			return m, m.skipToEnd()
+		case ".":
+			if m.state == stateRolling {
+				return m, m.skipToEnd()
+			}
+			return m, nil
+		case " ":
+			if m.state != stateRolling {
+				return m, nil
+			}
+			m.paused = !m.paused
+			var cmds []tea.Cmd
+			for i := range m.rolls {
+				if m.rolls[i].state != stateRolling {
+					continue
+				}
+				if m.paused {
+					m.rolls[i].tickSeq++ // drop the tick already in flight
+				} else {
+					m.rollStart = time.Now().Add(-m.elapsed) // don't count the pause
+					cmds = append(cmds, m.scheduleTick(i))
+				}
+			}
+			return m, tea.Batch(cmds...)
+		case "R":
+			m.reshuffle()
+			return m, tea.Batch(m.beginRound(), m.setNotice("reshuffled"))
+		case "backspace":
+			m.stepBack()
+			return m, nil
+		case "left", "right":
+			if browse {
+				m.browseStep(msg.String() == "right")
+			}
+			return m, nil
+		case "c":
+			if m.state == stateStopped {
+				return m, copyWord(strings.Join(m.currentWords(), " "))
+			}
+			return m, nil
+		case "r":
+			// Browse rounds don't roll, so there is nothing to replay.
+			if m.state == stateStopped && !browse {
+				m.replaying = true
+				return m, m.startRoll()
+			}
+			return m, nil
+		case "f":
+			if m.state == stateStopped {
+				return m, starWords(m.currentWords())
+			}
+			return m, nil
+		case "d":
+			m.showDefs = !m.showDefs
+			return m, nil
+		case "t":
+			if !colorEnabled() {
+				return m, m.setNotice("no colors in this terminal")
+			}
+			m.setTheme((m.themeIdx + 1) % len(themes))
+			m.themeSet = true
+			return m, m.setNotice("theme: " + themes[m.themeIdx].name)
+		case "s":
+			switch {
+			case m.state != stateStopped:
+			case reverseWords:
+				m.hidden = !m.hidden
+			default:
+				m.hidden = false
+			}
 			return m, nil
 		default:
 			return m, nil
 		}
 
+	case copiedMsg:
+		if msg.err != nil {
+			if quiet {
+				return m, m.setNotice("clipboard unavailable")
+			}
+			return m, m.setNotice("clipboard unavailable, word printed to stderr")
+		}
+		return m, m.setNotice("copied!")
+
+	case starredMsg:
+		switch {
+		case msg.err != nil:
+			return m, m.setNotice("couldn't save favorite: " + msg.err.Error())
+		case !msg.added:
+			return m, m.setNotice("already starred")
+		}
+		return m, m.setNotice("starred!")
+
+	case clearNoticeMsg:
+		if msg.seq == m.noticeSeq {
+			m.notice = ""
+			m.quitArmed = false // the quit prompt expires with its notice
+		}
+		return m, nil
+
 	case tea.MouseMsg:
+		m.lastActive = time.Now()
 		btn := msg.Button
-		if (btn == tea.MouseButtonWheelUp || btn == tea.MouseButtonWheelDown) && m.state == "stopped" {
+		if (btn == tea.MouseButtonWheelUp || btn == tea.MouseButtonWheelDown) && m.state == stateStopped {
 			cmd := m.beginRound()
 			return m, cmd
 		}
 		return m, nil
 
 	case rollTickMsg:
-		m.step++
-		if m.step >= wordsPerRound {
-			m.step = wordsPerRound - 1
-			m.state = "stopped"
+		if msg.board >= len(m.rolls) {
 			return m, nil
 		}
-		delayMs := rollDelaysMs[m.step]
-		return m, tea.Tick(time.Duration(delayMs)*time.Millisecond, func(t time.Time) tea.Msg {
-			return rollTickMsg{t: t}
-		})
+		r := &m.rolls[msg.board]
+		if msg.seq != r.tickSeq || m.paused || r.state != stateRolling {
+			return m, nil
+		}
+		debugLog.Printf("board %d tick at %s", msg.board, msg.t.Format("15:04:05.000"))
+		m.elapsed = msg.t.Sub(m.rollStart)
+		r.step++
+		if r.step < wordsPerRound {
+			return m, m.scheduleTick(msg.board)
+		}
+		r.step = wordsPerRound - 1
+		r.state = stateStopped
+		if m.allStopped() {
+			return m, m.finishRound()
+		}
+		return m, nil
+
+	case revealTickMsg:
+		if msg.board >= len(m.rolls) {
+			return m, nil
+		}
+		r := &m.rolls[msg.board]
+		if msg.seq != r.tickSeq || m.paused || r.state != stateRolling {
+			return m, nil
+		}
+		m.elapsed = msg.t.Sub(m.rollStart)
+		r.revealed++
+		if r.revealed < letterCount(r.word(m.words)) {
+			return m, m.scheduleTick(msg.board)
+		}
+		r.state = stateStopped
+		if m.allStopped() {
+			return m, m.finishRound()
+		}
+		return m, nil
 	}
 
 	return m, nil
 }
 
-var (
-	wordStyleRolling = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#E8E8E8")).
-			Background(lipgloss.Color("#1a1a2e")).
-			Padding(0, 2).
-			Margin(1, 0)
-	wordStyleFinal = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#00FF87")).
-			Background(lipgloss.Color("#0D1B2A")).
-			Padding(0, 2).
-			Margin(1, 0)
-	hintStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")).
-			MarginTop(1)
-)
-
 func (m model) View() string {
-	w := m.currentWord()
-	if w == "" && m.state == "stopped" && len(m.roundIdx) > 0 {
-		w = m.words[m.roundIdx[wordsPerRound-1]]
+	if m.showHelp {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.helpView(), lipgloss.WithWhitespaceChars(" "))
+	}
+	if m.showInfo {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.infoView(), lipgloss.WithWhitespaceChars(" "))
+	}
+	if compactView {
+		return m.compactLine()
+	}
+	blocks := make([]string, len(m.rolls))
+	for i, r := range m.rolls {
+		blocks[i] = m.renderBoard(r)
+		if i > 0 {
+			blocks[i] = lipgloss.NewStyle().MarginLeft(boardGap).Render(blocks[i])
+		}
+	}
+	block := lipgloss.JoinHorizontal(lipgloss.Top, blocks...)
+	status := m.notice
+	switch {
+	case m.paused:
+		status = "paused"
+	case m.replaying:
+		status = "replay"
+	}
+	body := block
+	if showSeed && m.state == stateStopped && m.round > 0 {
+		body += "\n" + m.styles.progress.Render(m.seedLine())
+	}
+	if m.showDefs && m.state == stateStopped && !m.hidden && len(m.rolls[0].roundIdx) > 0 {
+		body += "\n" + m.definitionView()
+	}
+	body += "\n" + m.progressBar() + "\n" + m.styles.notice.Render(status)
+	if !noHint {
+		body += "\n" + m.styles.hint.Render(hintLine())
+	}
+	if recent := m.recentHistory(); recent != "" {
+		body += "\n" + m.styles.history.Render(recent)
+	}
+	body += "\n" + m.styles.pool.Render(fmt.Sprintf("%d left before reshuffle", m.pool.Remaining()))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, body, lipgloss.WithWhitespaceChars(" "))
+}
+
+// seedLine is the --show-seed line, e.g. "seed 42 · round 3": run with --seed 42 and the
+// third round lands on the same word. A resumed pool can't be replayed from a seed, so it says so instead.
+func (m model) seedLine() string {
+	if m.resumed {
+		return fmt.Sprintf("round %d · resumed pool (use --fresh for a shareable seed)", m.round)
+	}
+	return fmt.Sprintf("seed %d · round %d", seed, m.round)
+}
+
+// compactLine is the --compact view: every board's word side by side, margins dropped.
+func (m model) compactLine() string {
+	words := make([]string, len(m.rolls))
+	for i, r := range m.rolls {
+		words[i] = m.renderBoard(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// hintLine is the --hint text, or the default reminder of the main keys.
+func hintLine() string {
+	if hintText != "" {
+		return hintText
+	}
+	newRound := "Enter or scroll"
+	if noMouse {
+		newRound = "Enter"
+	}
+	return newRound + " → new round   ·   ? → help   ·   q / Esc → quit"
+}
+
+// boardGap is the number of columns between boards.
+const boardGap = 2
+
+// renderBoard draws one board's word in a fixed-width block so it stays in place during the roll.
+func (m model) renderBoard(r roll) string {
+	w := r.word(m.words)
+	if w == "" && r.state == stateStopped {
+		w = r.finalWord(m.words)
 	}
 	if w == "" {
-		w = "-----"
+		w = strings.Repeat("-", wordLength)
+	}
+	if m.hidden && r.state == stateStopped {
+		w = r.puzzle
+	}
+	text := displayWord(w)
+	if r.state == stateRolling {
+		if letterReveal {
+			letters := []rune(text)
+			shown := min(r.revealed, len(letters))
+			text = string(letters[:shown]) + strings.Repeat("█", len(letters)-shown)
+		}
+		// Blank where the final word's mark goes, so the letters don't shift when it lands.
+		return m.styles.rolling.Render(strings.Repeat(" ", lipgloss.Width(m.styles.mark)) + text)
+	}
+	if m.freqColors && len(r.roundIdx) > 0 {
+		var b strings.Builder
+		for _, c := range text {
+			b.WriteString(m.styles.letter.Foreground(freqColor(c)).Render(string(c)))
+		}
+		text = b.String()
 	}
+	return m.styles.final.Render(m.styles.mark + text)
+}
 
-	var style lipgloss.Style
-	if m.state == "rolling" {
-		style = wordStyleRolling
-	} else {
-		style = wordStyleFinal
+// progressBarWidth is the number of cells in the rolling progress bar.
+const progressBarWidth = 10
+
+// progressBar renders e.g. "[####------] 6/16 · 840ms" for the slowest board while rolling;
+// once stopped only the roll's total time remains (empty if there was no roll).
+func (m model) progressBar() string {
+	if browse && len(m.rolls) > 0 {
+		return m.styles.progress.Render(fmt.Sprintf("← %d/%d →", m.rolls[0].step+1, wordsPerRound))
+	}
+	if m.state != stateRolling {
+		if m.elapsed == 0 {
+			return ""
+		}
+		return m.styles.progress.Render(fmt.Sprintf("%dms", m.elapsed.Milliseconds()))
+	}
+	total := wordsPerRound
+	if letterReveal {
+		total = wordLength
+	}
+	done := total
+	for _, r := range m.rolls {
+		switch {
+		case r.state != stateRolling:
+		case letterReveal:
+			done = min(done, r.revealed)
+		default:
+			done = min(done, r.step+1)
+		}
+	}
+	if done < 1 && !letterReveal {
+		return ""
 	}
+	filled := done * progressBarWidth / total
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled)
+	return m.styles.progress.Render(fmt.Sprintf("[%s] %d/%d · %dms", bar, done, total, m.elapsed.Milliseconds()))
+}
 
-	// Fixed-width block so the word stays in the same place during roll
-	block := style.Render(strings.ToUpper(w))
-	hint := hintStyle.Render("Enter or scroll → new round   ·   q / Esc → quit")
-	return lipgloss.Place(80, 12, lipgloss.Center, lipgloss.Center, block+"\n\n"+hint, lipgloss.WithWhitespaceChars(" "))
+// recentHistory lists the last historyShown revealed words, newest first. Words still
+// hidden by --scramble or --reverse are left out so the history doesn't give them away.
+func (m model) recentHistory() string {
+	end := len(m.history)
+	if m.hidden {
+		end = max(0, end-len(m.rolls))
+	}
+	n := min(end, historyShown)
+	recent := make([]string, 0, n)
+	for i := end - 1; i >= end-n; i-- {
+		recent = append(recent, displayWord(m.history[i]))
+	}
+	return strings.Join(recent, " · ")
 }
 
 func main() {
-	rand.Seed(time.Now().UnixNano())
-	p := tea.NewProgram(initialModel(), tea.WithMouseCellMotion())
-	if _, err := p.Run(); err != nil {
-		panic(err)
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "gimme-five: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// run parses flags, loads the word list and runs the chosen mode. Any error
+// (bad flags, empty pool after filters, TUI failure) becomes a non-zero exit in main; see exitCode.
+func run() error {
+	flag.Parse()
+	if cpuProfile != "" {
+		stop, err := startCPUProfile(cpuProfile)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	if verbose {
+		debugLog.SetOutput(os.Stderr)
+	}
+	if quiet {
+		warnLog.SetOutput(io.Discard)
+	}
+	if err := normalizeFlags(); err != nil {
+		return err
+	}
+	if showStats {
+		loadStats().print()
+		return nil
+	}
+	if listThemes {
+		printThemes()
+		return nil
+	}
+	if listCurves {
+		printCurves()
+		return nil
+	}
+	if listCategories {
+		return printCategories()
+	}
+	if category != "" {
+		set, err := loadCategory(category)
+		if err != nil {
+			return err
+		}
+		categoryWords = set
+	}
+	if showFavorites {
+		favs, err := loadFavorites()
+		if err != nil {
+			return fmt.Errorf("reading favorites: %w", err)
+		}
+		for _, w := range favs {
+			fmt.Println(w)
+		}
+		return nil
+	}
+	today := time.Now()
+	// A list piped on stdin can't be fingerprinted, so its daily word is never cached.
+	if daily && once && !dryRun && dictPath != stdinDict {
+		if w, ok := cachedDailyWord(today); ok {
+			seed = dailySeed(today)
+			warnLog.Printf("seed %d", seed)
+			return printWords([]string{w})
+		}
+	}
+	if blocklistPath != "" {
+		set, err := loadWordSet(blocklistPath)
+		if err != nil {
+			warnLog.Printf("%v; ignoring blocklist", err)
+		}
+		blocked = set
+	}
+	for _, w := range excludeWords {
+		if blocked == nil {
+			blocked = make(map[string]struct{})
+		}
+		blocked[strings.ToLower(strings.TrimSpace(w))] = struct{}{}
+	}
+	words, err := loadDictionary()
+	if err != nil {
+		return err
+	}
+	if err := checkRankRange(); err != nil {
+		return err
+	}
+	words = applyDifficulty(words, difficulty)
+	if countWords {
+		fmt.Println(len(words))
+		return nil
+	}
+	if dumpWords {
+		return dump(words)
+	}
+	if showSource {
+		fmt.Printf("%s: %d words\n", sourceLabel(), len(words))
+		return nil
+	}
+	if err := checkWords(words); err != nil {
+		return err
+	}
+	fiveLetterWords = words
+	if preferNew {
+		if seenWords, err = loadSeen(); err != nil {
+			return fmt.Errorf("reading history: %w", err)
+		}
+		debugLog.Printf("%d words in the history", len(seenWords))
+	}
+
+	switch {
+	case daily:
+		seed = dailySeed(today)
+	case !isFlagSet("seed"):
+		seed = today.UnixNano()
+	}
+	if dryRun {
+		if once {
+			if err := checkCount(); err != nil {
+				return err
+			}
+		}
+		printSummary()
+		return nil
+	}
+	warnLog.Printf("seed %d", seed)
+	rng := rand.New(rand.NewSource(seed))
+
+	if once {
+		return runOnce(rng, today)
+	}
+	return runTUI(rng, today)
+}
+
+// dump writes words to stdout one per line in --case (lowercase by default).
+func dump(words []string) error {
+	w := bufio.NewWriter(os.Stdout)
+	for _, word := range words {
+		fmt.Fprintln(w, applyCase(word, printCase))
+	}
+	return w.Flush()
+}
+
+// checkCount rejects a --count that can't be met without repeats.
+func checkCount() error {
+	if count > len(fiveLetterWords) && !allowRepeats {
+		return fmt.Errorf("--count %d exceeds the %d available words (use --allow-repeats)", count, len(fiveLetterWords))
+	}
+	return nil
+}
+
+// runOnce prints --count words (or today's word with --daily) without the TUI.
+func runOnce(rng *rand.Rand, today time.Time) error {
+	if err := checkCount(); err != nil {
+		return err
+	}
+	var words []string
+	if daily {
+		words = []string{fiveLetterWords[dailyIndex(today, len(fiveLetterWords))]}
+		if dictPath != stdinDict {
+			if err := saveDailyWord(today, words[0]); err != nil {
+				warnLog.Printf("caching daily word: %v", err)
+			}
+		}
+	} else if spread > 0 {
+		words = drawSpread(newPool(rng), count)
+	} else {
+		for _, i := range newPool(rng).Draw(count) {
+			words = append(words, fiveLetterWords[i])
+		}
+	}
+	return printWords(words)
+}
+
+// saveSession writes what a TUI session leaves behind: stats, the revealed words, the pool
+// position and a theme picked with t. Failures are reported but don't change the exit status.
+func saveSession(m model) {
+	if err := m.stats.save(); err != nil {
+		warnLog.Printf("saving stats: %v", err)
+	}
+	if err := appendHistory(m.history); err != nil {
+		warnLog.Printf("saving history: %v", err)
+	}
+	if persistPool() {
+		if err := savePoolState(m.pool.State()); err != nil {
+			warnLog.Printf("saving pool: %v", err)
+		}
+	}
+	if m.themeSet {
+		if err := saveTheme(themes[m.themeIdx].name); err != nil {
+			warnLog.Printf("saving theme: %v", err)
+		}
+	}
+}
+
+func runTUI(rng *rand.Rand, today time.Time) error {
+	delays := rollDelaysMs
+	if wordsPerRound != len(rollDelaysMs) {
+		delays = buildDelays(wordsPerRound)
+	}
+	effectiveDelays = scaleDelays(delays, speed)
+	if drama {
+		effectiveDelays = dramatize(effectiveDelays)
+	}
+	revealDelays = scaleDelays(buildDelays(wordLength), speed)
+	if name, ok := loadSavedTheme(); ok && !isFlagSet("theme") && !colorBlind {
+		themeName = name
+	}
+	var err error
+	if definitions, err = loadDefinitions(); err != nil {
+		return err
+	}
+	m := initialModel(rng)
+	if persistPool() && !fresh {
+		if st, ok := loadPoolState(); ok {
+			if err := m.pool.Restore(st); err != nil {
+				debugLog.Printf("saved pool discarded: %v", err)
+			} else {
+				m.resumed = true
+			}
+		}
+	}
+	if daily {
+		m.dailyIdx = dailyIndex(today, len(fiveLetterWords))
+	}
+	var opts []tea.ProgramOption
+	if !noMouse {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	// SIGINT/SIGTERM make Run return like q does, so they reach saveSession too.
+	final, err := tea.NewProgram(m, opts...).Run()
+	if fm, ok := final.(model); ok {
+		saveSession(fm)
+	}
+	if err != nil {
+		return err
+	}
+	if printHistory {
+		for _, w := range final.(model).history {
+			fmt.Println(applyCase(w, printCase))
+		}
 	}
+	return nil
 }
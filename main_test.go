@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/luismascotto/gimme-five-go/gimme"
+)
+
+var testWords = []string{"crane", "slate", "adieu", "roate", "soare", "trace", "crate", "stare", "raise", "arise"}
+
+// newTestModel is initialModel without the config files: a seeded pool over testWords and fresh stats.
+func newTestModel() model {
+	rolls := make([]roll, 1)
+	rolls[0].step = -1
+	return model{
+		words:     testWords,
+		pool:      gimme.NewPickerWithRand(testWords, rand.New(rand.NewSource(1))),
+		state:     stateRolling,
+		rolls:     rolls,
+		stats:     &stats{},
+		dailyIdx:  -1,
+		styles:    newStyles(plainTheme),
+		seenWords: make(map[string]bool),
+	}
+}
+
+func TestCurrentWordAndBoardFallback(t *testing.T) {
+	words := []string{"crane", "slate", "adieu"}
+	displayCase = "upper"
+	tests := []struct {
+		name     string
+		roll     roll
+		word     string // currentWord
+		rendered string // what renderBoard shows
+	}{
+		{"fresh", roll{step: -1, state: stateRolling}, "", "-----"},
+		{"mid-roll", roll{roundIdx: []int{0, 1, 2}, step: 1, state: stateRolling}, "slate", "SLATE"},
+		{"stopped", roll{roundIdx: []int{0, 1, 2}, step: 2, state: stateStopped}, "adieu", "ADIEU"},
+		{"stopped, step past the round", roll{roundIdx: []int{0, 1, 2}, step: 3, state: stateStopped}, "", "ADIEU"},
+		{"empty roundIdx", roll{step: 0, state: stateStopped}, "", "-----"},
+		{"out-of-range index", roll{roundIdx: []int{0, 7}, step: 1, state: stateStopped}, "", "-----"},
+		{"negative index", roll{roundIdx: []int{-1}, step: 0, state: stateRolling}, "", "-----"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := model{words: words, rolls: []roll{tt.roll}, styles: newStyles(plainTheme)}
+			if got := m.currentWord(); got != tt.word {
+				t.Errorf("currentWord() = %q, want %q", got, tt.word)
+			}
+			if got := m.renderBoard(tt.roll); !strings.Contains(got, tt.rendered) {
+				t.Errorf("renderBoard() = %q, want it to show %q", got, tt.rendered)
+			}
+		})
+	}
+}
+
+func TestCtrlCQuitsAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	m := newTestModel()
+	m.pool.Draw(3)
+	m.stats.TotalRounds = 7
+	m.showHelp, m.quitArmed = true, true
+
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("ctrl+c with an overlay and the quit prompt up returned no Cmd, want tea.Quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Fatalf("ctrl+c returned %T, want tea.QuitMsg", cmd())
+	}
+
+	saveSession(next.(model))
+	if got := loadStats(); got.TotalRounds != 7 {
+		t.Errorf("stats.json after quitting: total_rounds = %d, want 7", got.TotalRounds)
+	}
+	st, ok := loadPoolState()
+	if !ok {
+		t.Fatal("pool.json was not written")
+	}
+	if st != m.pool.State() {
+		t.Errorf("pool.json = %+v, want %+v", st, m.pool.State())
+	}
+}
+
+func TestCurrentWordNoBoards(t *testing.T) {
+	if got := (model{}).currentWord(); got != "" {
+		t.Fatalf("currentWord() with no boards = %q, want \"\"", got)
+	}
+}
+
+func TestDisplayWord(t *testing.T) {
+	t.Cleanup(func() { displayCase = "upper" })
+	tests := []struct {
+		word, style, want string
+	}{
+		{"crane", "upper", "CRANE"},
+		{"crane", "lower", "crane"},
+		{"crane", "title", "Crane"},
+		{"ǆemal", "title", "ǅemal"}, // title case, not upper (Ǆ)
+		{"ǆemal", "upper", "ǄEMAL"},
+		{"cañón", "upper", "CAÑÓN"},
+		{"cañón", "title", "Cañón"},
+		{"", "title", ""},
+	}
+	for _, tt := range tests {
+		displayCase = tt.style
+		got := displayWord(tt.word)
+		if got != tt.want {
+			t.Errorf("displayWord(%q) with --case %s = %q, want %q", tt.word, tt.style, got, tt.want)
+		}
+		if got != applyCase(tt.word, tt.style) {
+			t.Errorf("displayWord(%q) = %q, differs from applyCase(%q, %q)", tt.word, got, tt.word, tt.style)
+		}
+		if n, want := utf8.RuneCountInString(got), utf8.RuneCountInString(tt.word); n != want {
+			t.Errorf("displayWord(%q) with --case %s has %d runes, want %d", tt.word, tt.style, n, want)
+		}
+	}
+}
+
+func TestEmptyDictionary(t *testing.T) {
+	dictRanked = 0
+	words := loadWords(strings.NewReader(""))
+	if len(words) != 0 {
+		t.Fatalf("loadWords(empty) = %q, want no words", words)
+	}
+	if err := checkWords(words); !errors.Is(err, gimme.ErrEmptyDictionary) {
+		t.Fatalf("checkWords after an empty reader: err = %v, want gimme.ErrEmptyDictionary", err)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{errorOf(gimme.ErrBadPattern, "bad --regex: %w", errors.New("missing )")), exitBadPattern},
+		{errorOf(gimme.ErrEmptyDictionary, "no 5-letter words in %s", "words.txt"), exitEmptyDictionary},
+		{errorOf(gimme.ErrNoMatch, "no 5-letter words match"), exitNoMatch},
+		{fmt.Errorf("--%w", gimme.CheckPattern("cr_n", 5)), exitBadPattern},
+		{errors.New("reading history: permission denied"), 1},
+	}
+	for _, tt := range tests {
+		if got := exitCode(tt.err); got != tt.want {
+			t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+	if exitBadPattern != 2 || exitEmptyDictionary != 3 || exitNoMatch != 4 {
+		t.Fatalf("exit statuses = %d/%d/%d, want 2/3/4 as documented", exitBadPattern, exitEmptyDictionary, exitNoMatch)
+	}
+}
+
+func TestLoadWordsDedup(t *testing.T) {
+	dictRanked = 0
+	got := loadWords(strings.NewReader("Crane\ncrane\nslate\nCRANE\n"))
+	if want := []string{"crane", "slate"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("loadWords = %q, want %q", got, want)
+	}
+	if dictRanked != 2 {
+		t.Fatalf("dictRanked = %d, want 2 (duplicates don't take a rank)", dictRanked)
+	}
+}
+
+func TestLoadWordsMultibyteLength(t *testing.T) {
+	t.Cleanup(func() { unicodeLetters = false })
+	input := "cañón\ncañones\nniño\n"
+	if n := letterCount("cañón"); n != 5 {
+		t.Fatalf("letterCount(\"cañón\") = %d, want 5", n)
+	}
+
+	unicodeLetters = true
+	dictRanked = 0
+	if got, want := loadWords(strings.NewReader(input)), []string{"cañón"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("with --unicode-letters: loadWords = %q, want %q", got, want)
+	}
+
+	unicodeLetters = false
+	dictRanked = 0
+	if got := loadWords(strings.NewReader(input)); len(got) != 0 {
+		t.Fatalf("without --unicode-letters: loadWords = %q, want none", got)
+	}
+}
+
+func TestIsCommentOrBlank(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"", true},
+		{"   \t", true},
+		{"#", true},
+		{"# five-letter answers", true},
+		{"  # indented", true},
+		{"crane", false},
+		{"  slate  ", false},
+		{"ab#cd", false},
+	}
+	for _, tt := range tests {
+		// Callers trim first, as loadWords and readWordSet do.
+		if got := isCommentOrBlank(bytes.TrimSpace([]byte(tt.line))); got != tt.want {
+			t.Errorf("isCommentOrBlank(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+
+	dictRanked = 0
+	got := loadWords(strings.NewReader("# list\n\ncrane\n  # indented\nslate\n#adieu\n"))
+	if want := []string{"crane", "slate"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("loadWords on a commented file = %q, want %q", got, want)
+	}
+}
+
+// BenchmarkLoadWords parses the embedded list with the default filters. Reading lengths off
+// the raw bytes and pre-sizing with sizeHint took it from about 370k to 16k allocs/op.
+func BenchmarkLoadWords(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dictRanked = 0
+		loadWords(bytes.NewReader(wordsAlphaTxt))
+	}
+}
+
+func TestStaleAutoRoundIsDropped(t *testing.T) {
+	instant = true
+	t.Cleanup(func() { instant = false })
+	m := newTestModel()
+	m.auto = true
+
+	update := func(msg tea.Msg) {
+		t.Helper()
+		next, _ := m.Update(msg)
+		m = next.(model)
+	}
+	update(startRoundMsg{}) // first round; schedules auto round 1
+	stale := m.autoSeq
+	update(tea.MouseMsg{Button: tea.MouseButtonWheelDown}) // schedules auto round 2
+	if m.played != 2 {
+		t.Fatalf("played after a wheel scroll = %d, want 2", m.played)
+	}
+
+	update(startRoundMsg{auto: true, seq: stale})
+	if m.played != 2 {
+		t.Fatalf("stale auto round was started: played = %d, want 2", m.played)
+	}
+	update(startRoundMsg{auto: true, seq: m.autoSeq})
+	if m.played != 3 {
+		t.Fatalf("current auto round: played = %d, want 3", m.played)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// wordResult is one --json record.
+type wordResult struct {
+	Word   string `json:"word"`
+	Seed   int64  `json:"seed"`
+	Length int    `json:"length"`
+}
+
+// printSummary is the --dry-run report: the word list, the flags given and the seed.
+func printSummary() {
+	source := dictSource
+	if source == "" {
+		source = "embedded word list"
+	}
+	fmt.Printf("words:  %d %d-letter words from %s\n", len(fiveLetterWords), wordLength, source)
+	var given []string
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name != "dry-run" {
+			given = append(given, "--"+f.Name+"="+f.Value.String())
+		}
+	})
+	if len(given) == 0 {
+		given = []string{"none"}
+	}
+	fmt.Printf("flags:  %s\n", strings.Join(given, " "))
+	fmt.Printf("seed:   %d\n", seed)
+}
+
+// formatFields is what a --format template sees for each word.
+type formatFields struct {
+	Word   string // in --case (lowercase by default)
+	Upper  string
+	Length int
+	Seed   int64
+}
+
+// parseFormat compiles the --format template and runs it once on a sample word,
+// so both bad syntax and unknown fields are reported before any word is picked.
+func parseFormat(text string) (*template.Template, error) {
+	t, err := template.New("format").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("--format: %w", err)
+	}
+	if err := t.Execute(io.Discard, formatFields{Word: "crane", Upper: "CRANE", Length: 5}); err != nil {
+		return nil, fmt.Errorf("--format: %w", err)
+	}
+	return t, nil
+}
+
+// printWords writes --once output: one word per line, each rendered by --format if given,
+// or JSON with --json (a single object for one word, an array for --count > 1).
+func printWords(words []string) error {
+	if formatTemplate != nil {
+		for _, w := range words {
+			f := formatFields{Word: applyCase(w, printCase), Upper: strings.ToUpper(w), Length: wordLength, Seed: seed}
+			if err := formatTemplate.Execute(os.Stdout, f); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+	if !jsonOutput {
+		for _, w := range words {
+			fmt.Println(applyCase(w, printCase))
+		}
+		return nil
+	}
+	results := make([]wordResult, len(words))
+	for i, w := range words {
+		results[i] = wordResult{Word: applyCase(w, printCase), Seed: seed, Length: wordLength}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	if count > 1 {
+		return enc.Encode(results)
+	}
+	return enc.Encode(results[0])
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/luismascotto/gimme-five-go/gimme"
+)
+
+// The pool position is kept in pool.json under configPath so a new session
+// continues the previous shuffle instead of starting one that may repeat recent words.
+
+// loadPoolState reads the saved pool position; ok is false if there is none or it is corrupt.
+func loadPoolState() (st gimme.State, ok bool) {
+	path, err := configPath("pool.json")
+	if err != nil {
+		return st, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return st, false
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return gimme.State{}, false
+	}
+	return st, true
+}
+
+func savePoolState(st gimme.State) error {
+	path, err := configPath("pool.json")
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// persistPool reports whether this session resumes and saves the pool: not when a seed
+// is given (--seed, --daily), since that run should be reproducible on its own, nor with
+// --prefer-new, whose shuffle depends on the history and so changes from one session to the next
+// (the history already keeps it from repeating words).
+func persistPool() bool {
+	return !daily && !isFlagSet("seed") && !preferNew
+}
@@ -0,0 +1,49 @@
+package main
+
+// roll is one board's roulette: the words it flashes this round and how far it has spun.
+// The model holds one per --boards; a round is complete once every roll has stopped.
+type roll struct {
+	roundIdx []int     // indices for current round (len wordsPerRound)
+	step     int       // 0..wordsPerRound-1 during roll
+	state    gameState // stateRolling | stateStopped
+	tickSeq  int       // bumped per scheduled tick so stale ticks (e.g. across a pause) are ignored
+	puzzle   string    // the final word as shown until revealed: scrambled (--scramble) or reversed (--reverse)
+	revealed int       // letters of the final word uncovered so far (--letter-reveal)
+}
+
+// boardStagger stretches each extra board's delays (board i is i*15% slower), so boards land one after another.
+const boardStagger = 0.15
+
+// word is the word the roll currently shows: "" before the first round (no roundIdx,
+// step -1) or if step or its index is out of range, rather than panicking mid-render.
+func (r roll) word(words []string) string {
+	if r.step < 0 || r.step >= len(r.roundIdx) {
+		return ""
+	}
+	return wordAt(words, r.roundIdx[r.step])
+}
+
+// finalWord is the word the roll lands on, or "" before the first round.
+func (r roll) finalWord(words []string) string {
+	if len(r.roundIdx) == 0 {
+		return ""
+	}
+	return wordAt(words, r.roundIdx[len(r.roundIdx)-1])
+}
+
+func wordAt(words []string, idx int) string {
+	if idx < 0 || idx >= len(words) {
+		return ""
+	}
+	return words[idx]
+}
+
+// delay is how long board shows its current step, in ms.
+func (r roll) delay(board int) int {
+	return int(float64(effectiveDelays[r.step]) * (1 + boardStagger*float64(board)))
+}
+
+// revealDelay is how long board waits before uncovering its next letter (--letter-reveal), in ms.
+func (r roll) revealDelay(board int) int {
+	return int(float64(revealDelays[min(r.revealed, len(revealDelays)-1)]) * (1 + boardStagger*float64(board)))
+}
@@ -0,0 +1,34 @@
+package main
+
+import "math/rand"
+
+// scramble returns word with its letters shuffled by r, never in the original order
+// unless no other order exists (a single letter, or one letter repeated).
+func scramble(word string, r *rand.Rand) string {
+	letters := []rune(word)
+	distinct := false
+	for _, c := range letters[min(1, len(letters)):] {
+		if c != letters[0] {
+			distinct = true
+			break
+		}
+	}
+	if !distinct {
+		return word
+	}
+	for {
+		r.Shuffle(len(letters), func(i, j int) { letters[i], letters[j] = letters[j], letters[i] })
+		if s := string(letters); s != word {
+			return s
+		}
+	}
+}
+
+// reverseString returns s spelled backwards, rune by rune ("niño" → "oñin").
+func reverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
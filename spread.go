@@ -0,0 +1,51 @@
+package main
+
+import "github.com/luismascotto/gimme-five-go/gimme"
+
+// maxSpreadSkips bounds how many too-close candidates --spread may skip in a row before it
+// takes the next one anyway, so a small or very uniform pool can't loop forever.
+const maxSpreadSkips = 50
+
+// hammingDistance counts the positions at which a and b differ, rune by rune; any extra
+// letters in the longer word count as differences.
+func hammingDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := len(ra) - len(rb)
+	if d < 0 {
+		ra, rb, d = rb, ra, -d
+	}
+	for i := range rb {
+		if ra[i] != rb[i] {
+			d++
+		}
+	}
+	return d
+}
+
+// drawSpread draws n words from pool, skipping those within --spread letters of the previous
+// one (e.g. "crane" after "crone" at --spread 2) and, without --allow-repeats, already drawn words.
+func drawSpread(pool *gimme.Picker, n int) []string {
+	words := make([]string, 0, n)
+	drawn := make(map[string]bool, n)
+	skips := 0
+	for len(words) < n {
+		w := pool.Next()
+		if drawn[w] && !allowRepeats {
+			// Never relaxed: checkCount made sure there are enough distinct words, and the
+			// pool hands each of them out once per cycle.
+			continue
+		}
+		tooClose := len(words) > 0 && hammingDistance(words[len(words)-1], w) < spread
+		if tooClose && skips < maxSpreadSkips {
+			skips++
+			continue
+		}
+		if skips == maxSpreadSkips {
+			debugLog.Printf("--spread: took %q after %d skips", w, skips)
+		}
+		skips = 0
+		drawn[w] = true
+		words = append(words, w)
+	}
+	return words
+}
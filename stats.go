@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// stats is the lifetime play record kept in stats.json under configPath.
+type stats struct {
+	TotalRounds int       `json:"total_rounds"`
+	LastPlayed  time.Time `json:"last_played"`
+}
+
+// loadStats reads the stats file; a missing or corrupt file starts fresh.
+func loadStats() *stats {
+	s := &stats{}
+	path, err := configPath("stats.json")
+	if err != nil {
+		return s
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return &stats{}
+	}
+	return s
+}
+
+func (s *stats) save() error {
+	path, err := configPath("stats.json")
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// recordRound counts a finished round.
+func (s *stats) recordRound(at time.Time) {
+	s.TotalRounds++
+	s.LastPlayed = at
+}
+
+func (s *stats) print() {
+	fmt.Printf("rounds played: %d\n", s.TotalRounds)
+	if s.LastPlayed.IsZero() {
+		fmt.Println("last played:   never")
+		return
+	}
+	fmt.Printf("last played:   %s\n", s.LastPlayed.Format(time.RFC1123))
+}
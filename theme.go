@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// theme is a named palette for the TUI. mono themes use no colors at all and
+// mark the final word with reverse video instead, so they work on any terminal.
+type theme struct {
+	name                 string
+	rollingFg, rollingBg lipgloss.TerminalColor
+	finalFg, finalBg     lipgloss.TerminalColor
+	hint, history        lipgloss.TerminalColor
+	notice, pool         lipgloss.TerminalColor
+	mono                 bool
+	mark                 string // shown before the final word, a cue that doesn't rely on color
+}
+
+// themes in --theme order; the first is the default.
+var themes = []theme{
+	{
+		name:      "default",
+		rollingFg: lipgloss.Color("#E8E8E8"), rollingBg: lipgloss.Color("#1a1a2e"),
+		finalFg: lipgloss.Color("#00FF87"), finalBg: lipgloss.Color("#0D1B2A"),
+		hint: lipgloss.Color("#6B7280"), history: lipgloss.Color("#4B5563"),
+		notice: lipgloss.Color("#FBBF24"), pool: lipgloss.Color("#374151"),
+	},
+	{
+		name:      "mono",
+		rollingFg: lipgloss.NoColor{}, rollingBg: lipgloss.NoColor{},
+		finalFg: lipgloss.NoColor{}, finalBg: lipgloss.NoColor{},
+		hint: lipgloss.NoColor{}, history: lipgloss.NoColor{},
+		notice: lipgloss.NoColor{}, pool: lipgloss.NoColor{},
+		mono: true,
+	},
+	{
+		name:      "solarized",
+		rollingFg: lipgloss.Color("#93A1A1"), rollingBg: lipgloss.Color("#002B36"),
+		finalFg: lipgloss.Color("#B58900"), finalBg: lipgloss.Color("#073642"),
+		hint: lipgloss.Color("#586E75"), history: lipgloss.Color("#657B83"),
+		notice: lipgloss.Color("#CB4B16"), pool: lipgloss.Color("#586E75"),
+	},
+	{
+		name:      "highcontrast",
+		rollingFg: lipgloss.Color("#FFFFFF"), rollingBg: lipgloss.Color("#000000"),
+		finalFg: lipgloss.Color("#000000"), finalBg: lipgloss.Color("#FFFF00"),
+		hint: lipgloss.Color("#FFFFFF"), history: lipgloss.Color("#C0C0C0"),
+		notice: lipgloss.Color("#00FFFF"), pool: lipgloss.Color("#C0C0C0"),
+	},
+	{
+		// Okabe–Ito colors: the final word is dark on yellow, far brighter than the dim
+		// rolling words for any color vision, and marked with a check as well.
+		name:      "colorblind",
+		rollingFg: lipgloss.Color("#A0A0A0"), rollingBg: lipgloss.Color("#202020"),
+		finalFg: lipgloss.Color("#000000"), finalBg: lipgloss.Color("#F0E442"),
+		hint: lipgloss.Color("#8C8C8C"), history: lipgloss.Color("#8C8C8C"),
+		notice: lipgloss.Color("#56B4E9"), pool: lipgloss.Color("#707070"),
+		mark: "✓ ",
+	},
+}
+
+// plainTheme is used whenever color is unavailable: no colors, no reverse, just text.
+var plainTheme = theme{
+	name:      "plain",
+	rollingFg: lipgloss.NoColor{}, rollingBg: lipgloss.NoColor{},
+	finalFg: lipgloss.NoColor{}, finalBg: lipgloss.NoColor{},
+	hint: lipgloss.NoColor{}, history: lipgloss.NoColor{},
+	notice: lipgloss.NoColor{}, pool: lipgloss.NoColor{},
+}
+
+// colorEnabled is false when NO_COLOR is set (https://no-color.org) or the terminal has no color support.
+func colorEnabled() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	return lipgloss.ColorProfile() != termenv.Ascii
+}
+
+// themeIndex is the position of the named theme in themes, or -1 if there is none.
+func themeIndex(name string) int {
+	for i, t := range themes {
+		if t.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func themeByName(name string) (theme, bool) {
+	i := themeIndex(name)
+	if i < 0 {
+		return theme{}, false
+	}
+	return themes[i], true
+}
+
+// loadSavedTheme returns the theme last picked with t (theme.txt under configPath),
+// if any and still known.
+func loadSavedTheme() (string, bool) {
+	path, err := configPath("theme.txt")
+	if err != nil {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	name := strings.TrimSpace(string(data))
+	return name, themeIndex(name) >= 0
+}
+
+func saveTheme(name string) error {
+	path, err := configPath("theme.txt")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(name+"\n"), 0o644)
+}
+
+// Letter colors for --freq-colors, by letterFreq band.
+var (
+	freqCommon = lipgloss.Color("#22C55E") // at least freqCommonPct
+	freqMiddle = lipgloss.Color("#EAB308")
+	freqRare   = lipgloss.Color("#EF4444") // below freqRarePct
+)
+
+const (
+	freqCommonPct = 5.0 // e t a o i n s h r
+	freqRarePct   = 1.5 // v k j x q z (and b)
+)
+
+// freqColor is the --freq-colors color of letter c (either case): green for common
+// English letters, yellow for middling ones, red for rare ones and non-letters.
+func freqColor(c rune) lipgloss.Color {
+	switch f := letterFreq[unicode.ToLower(c)]; {
+	case f >= freqCommonPct:
+		return freqCommon
+	case f >= freqRarePct:
+		return freqMiddle
+	default:
+		return freqRare
+	}
+}
+
+// printThemes lists the themes for --list-themes, each with a rolling and a final
+// sample word in its colors (plain names only when color is unavailable).
+func printThemes() {
+	for _, t := range themes {
+		if !colorEnabled() {
+			fmt.Println(t.name)
+			continue
+		}
+		sample := lipgloss.NewStyle().Bold(true).Padding(0, 1)
+		rolling := sample.Foreground(t.rollingFg).Background(t.rollingBg).Render("CRANE")
+		final := sample.Foreground(t.finalFg).Background(t.finalBg).Reverse(t.mono).Render("SLATE")
+		fmt.Printf("%-13s %s %s\n", t.name, rolling, final)
+	}
+}
+
+// styles are the lipgloss styles View renders with, built from a theme.
+type styles struct {
+	rolling, final lipgloss.Style
+	hint, history  lipgloss.Style
+	notice, pool   lipgloss.Style
+	progress       lipgloss.Style
+	definition     lipgloss.Style // the d panel under the word; width set per render
+	mark           string         // theme.mark
+	letter         lipgloss.Style // one letter of the final word with --freq-colors; foreground set per letter
+}
+
+func newStyles(t theme) styles {
+	word := lipgloss.NewStyle().Bold(true).Padding(0, 2).Margin(1, 0)
+	dim := lipgloss.NewStyle().Faint(t.mono)
+	return styles{
+		rolling:    word.Foreground(t.rollingFg).Background(t.rollingBg),
+		final:      word.Foreground(t.finalFg).Background(t.finalBg).Reverse(t.mono),
+		hint:       dim.Foreground(t.hint).MarginTop(1),
+		history:    dim.Foreground(t.history),
+		notice:     lipgloss.NewStyle().Foreground(t.notice),
+		pool:       dim.Foreground(t.pool),
+		progress:   dim.Foreground(t.history),
+		definition: dim.Foreground(t.hint).Italic(true).Align(lipgloss.Center),
+		letter:     lipgloss.NewStyle().Bold(true).Background(t.finalBg),
+		mark:       t.mark,
+	}
+}
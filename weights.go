@@ -0,0 +1,59 @@
+package main
+
+import "strings"
+
+// scrabbleValues are the standard English Scrabble tile points.
+var scrabbleValues = map[rune]int{
+	'a': 1, 'e': 1, 'i': 1, 'o': 1, 'u': 1, 'l': 1, 'n': 1, 's': 1, 't': 1, 'r': 1,
+	'd': 2, 'g': 2,
+	'b': 3, 'c': 3, 'm': 3, 'p': 3,
+	'f': 4, 'h': 4, 'v': 4, 'w': 4, 'y': 4,
+	'k': 5,
+	'j': 8, 'x': 8,
+	'q': 10, 'z': 10,
+}
+
+// scrabbleScore is the sum of a word's tile values (no board bonuses).
+func scrabbleScore(word string) int {
+	score := 0
+	for _, c := range word {
+		score += scrabbleValues[c]
+	}
+	return score
+}
+
+// starterLetters are the most frequent English letters (ETAOIN SHR), the ones a good
+// Wordle opener tries to cover.
+const starterLetters = "etaoinshr"
+
+// starterScore is how many different starterLetters word contains: 5 for "stare", 0 for "fuzzy".
+func starterScore(word string) int {
+	score := 0
+	for _, c := range starterLetters {
+		if strings.ContainsRune(word, c) {
+			score++
+		}
+	}
+	return score
+}
+
+// poolWeights returns per-word shuffle weights for the active bias flags, or nil for a uniform
+// shuffle. With both flags the weights multiply.
+func poolWeights(words []string) []float64 {
+	if !scrabbleBias && !starters {
+		return nil
+	}
+	weights := make([]float64, len(words))
+	for i, w := range words {
+		weights[i] = 1
+		if scrabbleBias {
+			weights[i] *= float64(max(scrabbleScore(w), 1))
+		}
+		if starters {
+			// Each covered letter doubles the weight: strong openers come up far more
+			// often, but every word still shows once per cycle.
+			weights[i] *= float64(int(1) << starterScore(w))
+		}
+	}
+	return weights
+}